@@ -0,0 +1,259 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package backend
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-memdb"
+)
+
+// AsyncOperationState is the state of an AsyncOperation.
+type AsyncOperationState string
+
+const (
+	AsyncOperationPending AsyncOperationState = "pending"
+	AsyncOperationRunning AsyncOperationState = "running"
+	AsyncOperationDone    AsyncOperationState = "done"
+	AsyncOperationFailed  AsyncOperationState = "failed"
+)
+
+// asyncOperationPollInterval is how often WaitForAsyncOperation reads the
+// operation.
+const asyncOperationPollInterval = 50 * time.Millisecond
+
+// asyncOperationRetention is how long an operation is kept after it is done
+// or failed, so that pollers can read its final state, before it is purged.
+const asyncOperationRetention = 10 * time.Minute
+
+// AsyncOperationRequest describes a long-running operation to start.
+type AsyncOperationRequest struct {
+	// PendingDuration is how long the operation is pending before it runs.
+	PendingDuration time.Duration
+
+	// RunningDuration is how long the operation runs before it is done.
+	RunningDuration time.Duration
+
+	// Error makes the operation fail with the message, instead of being
+	// done, once it has run.
+	Error string
+}
+
+// AsyncOperation is a long-running operation started by StartAsyncOperation.
+// It moves from pending to running to done or failed as time passes.
+type AsyncOperation struct {
+	ID        string
+	StartedAt time.Time
+
+	PendingDuration time.Duration
+	RunningDuration time.Duration
+	Error           string
+
+	// State is the state of the operation when it was read.
+	State AsyncOperationState
+}
+
+// state returns the state of the operation at the given time.
+func (o *AsyncOperation) state(now time.Time) AsyncOperationState {
+	running := o.StartedAt.Add(o.PendingDuration)
+
+	switch {
+	case now.Before(running):
+		return AsyncOperationPending
+	case now.Before(running.Add(o.RunningDuration)):
+		return AsyncOperationRunning
+	case o.Error != "":
+		return AsyncOperationFailed
+	default:
+		return AsyncOperationDone
+	}
+}
+
+// expired returns whether the operation has been done or failed for longer
+// than asyncOperationRetention at the given time, after which it is purged.
+func (o *AsyncOperation) expired(now time.Time) bool {
+	finished := o.StartedAt.Add(o.PendingDuration + o.RunningDuration)
+
+	return !now.Before(finished.Add(asyncOperationRetention))
+}
+
+// StartAsyncOperation starts a long-running operation and returns its ID.
+func (c *Client) StartAsyncOperation(req AsyncOperationRequest) (string, error) {
+	var id string
+
+	err := c.faulted(OperationStartAsyncOperation, func() error {
+		if c.remote != nil {
+			var err error
+			id, err = c.remote.startAsyncOperation(req)
+
+			return err
+		}
+
+		return c.persisted(true, func() error {
+			var err error
+			id, err = c.startAsyncOperation(req)
+
+			return err
+		})
+	})
+
+	return id, err
+}
+
+// ReadAsyncOperation returns the operation with the given ID, or nil if
+// there is none or it has expired.
+func (c *Client) ReadAsyncOperation(id string) (*AsyncOperation, error) {
+	var op *AsyncOperation
+
+	err := c.faulted(OperationReadAsyncOperation, func() error {
+		if c.remote != nil {
+			var err error
+			op, err = c.remote.readAsyncOperation(id)
+
+			return err
+		}
+
+		return c.persisted(false, func() error {
+			var err error
+			op, err = c.readAsyncOperation(id)
+			if err != nil {
+				return err
+			}
+
+			c.journal.record(OperationReadAsyncOperation, id, nil, clone(op))
+
+			return nil
+		})
+	})
+
+	return op, err
+}
+
+// WaitForAsyncOperation polls the operation with the given ID until it is
+// done, erroring if it fails, does not exist, or ctx is done first.
+func (c *Client) WaitForAsyncOperation(ctx context.Context, id string) (*AsyncOperation, error) {
+	ticker := time.NewTicker(asyncOperationPollInterval)
+	defer ticker.Stop()
+
+	for {
+		op, err := c.ReadAsyncOperation(id)
+		if err != nil {
+			return nil, err
+		}
+
+		if op == nil {
+			return nil, fmt.Errorf("Error waiting for operation %s: operation not in db", id)
+		}
+
+		switch op.State {
+		case AsyncOperationDone:
+			return op, nil
+		case AsyncOperationFailed:
+			return op, fmt.Errorf("Operation %s failed: %s", id, op.Error)
+		}
+
+		select {
+		case <-ctx.Done():
+			return op, fmt.Errorf("Timed out waiting for operation %s, which is still %s: %w", id, op.State, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *Client) startAsyncOperation(req AsyncOperationRequest) (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	op := &AsyncOperation{
+		ID:              "op-" + hex.EncodeToString(b),
+		StartedAt:       time.Now(),
+		PendingDuration: req.PendingDuration,
+		RunningDuration: req.RunningDuration,
+		Error:           req.Error,
+	}
+
+	txn := c.db.Txn(true)
+	defer txn.Abort()
+
+	if err := purgeAsyncOperations(txn, op.StartedAt); err != nil {
+		return "", err
+	}
+
+	if err := txn.Insert("operations", op); err != nil {
+		return "", err
+	}
+
+	txn.Commit()
+
+	c.journal.record(OperationStartAsyncOperation, op.ID, nil, clone(op))
+
+	return op.ID, nil
+}
+
+func (c *Client) readAsyncOperation(id string) (*AsyncOperation, error) {
+	txn := c.db.Txn(false)
+	defer txn.Abort()
+
+	raw, err := txn.First("operations", "id", id)
+	if err != nil {
+		return nil, err
+	}
+
+	if raw == nil {
+		return nil, nil
+	}
+
+	stored, ok := raw.(*AsyncOperation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected type %T while reading operation", raw)
+	}
+
+	now := time.Now()
+
+	if stored.expired(now) {
+		return nil, nil
+	}
+
+	// copy, as objects in memdb must not be modified in place
+	op := *stored
+	op.State = op.state(now)
+
+	return &op, nil
+}
+
+// purgeAsyncOperations deletes the operations that have expired at the given
+// time, which requires txn to be a write transaction.
+func purgeAsyncOperations(txn *memdb.Txn, now time.Time) error {
+	it, err := txn.Get("operations", "id")
+	if err != nil {
+		return err
+	}
+
+	var expired []*AsyncOperation
+
+	for raw := it.Next(); raw != nil; raw = it.Next() {
+		op, ok := raw.(*AsyncOperation)
+		if !ok {
+			return fmt.Errorf("unexpected type %T while reading operation", raw)
+		}
+
+		if op.expired(now) {
+			expired = append(expired, op)
+		}
+	}
+
+	for _, op := range expired {
+		if err := txn.Delete("operations", op); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,193 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package backend
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAsyncOperation_state(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	op := &AsyncOperation{
+		StartedAt:       start,
+		PendingDuration: time.Second,
+		RunningDuration: time.Second,
+	}
+
+	testCases := map[time.Duration]AsyncOperationState{
+		0:                       AsyncOperationPending,
+		time.Second:             AsyncOperationRunning,
+		2*time.Second - 1:       AsyncOperationRunning,
+		2 * time.Second:         AsyncOperationDone,
+		time.Hour + time.Second: AsyncOperationDone,
+	}
+
+	for elapsed, expected := range testCases {
+		if got := op.state(start.Add(elapsed)); got != expected {
+			t.Errorf("expected %s after %s, got: %s", expected, elapsed, got)
+		}
+	}
+
+	op.Error = "quota exceeded"
+
+	if got := op.state(start.Add(time.Hour)); got != AsyncOperationFailed {
+		t.Errorf("expected %s, got: %s", AsyncOperationFailed, got)
+	}
+}
+
+func TestClient_WaitForAsyncOperation(t *testing.T) {
+	t.Parallel()
+
+	for name, c := range map[string]*Client{
+		"local":  newFreshClient(t),
+		"remote": newTestServer(t, nil),
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			id, err := c.StartAsyncOperation(AsyncOperationRequest{
+				PendingDuration: 200 * time.Millisecond,
+				RunningDuration: 50 * time.Millisecond,
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			op, err := c.ReadAsyncOperation(id)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if op == nil || op.State != AsyncOperationPending {
+				t.Fatalf("expected pending operation, got: %+v", op)
+			}
+
+			op, err = c.WaitForAsyncOperation(context.Background(), id)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if op.State != AsyncOperationDone {
+				t.Errorf("expected done operation, got: %+v", op)
+			}
+
+			id, err = c.StartAsyncOperation(AsyncOperationRequest{Error: "quota exceeded"})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if _, err := c.WaitForAsyncOperation(context.Background(), id); err == nil || !strings.Contains(err.Error(), "quota exceeded") {
+				t.Errorf("expected operation to fail, got: %v", err)
+			}
+
+			op, err = c.ReadAsyncOperation("op-missing")
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if op != nil {
+				t.Errorf("expected no operation, got: %+v", op)
+			}
+		})
+	}
+}
+
+func TestClient_WaitForAsyncOperation_timeout(t *testing.T) {
+	t.Parallel()
+
+	c := newFreshClient(t)
+
+	id, err := c.StartAsyncOperation(AsyncOperationRequest{RunningDuration: time.Hour})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	_, err = c.WaitForAsyncOperation(ctx, id)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got: %v", err)
+	}
+
+	if !strings.Contains(err.Error(), "which is still running") {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
+
+func TestClient_StartAsyncOperation_purge(t *testing.T) {
+	t.Parallel()
+
+	c := newFreshClient(t)
+
+	now := time.Now()
+
+	expired := &AsyncOperation{
+		ID:              "op-expired",
+		StartedAt:       now.Add(-asyncOperationRetention - time.Minute),
+		RunningDuration: time.Second,
+	}
+
+	finished := &AsyncOperation{
+		ID:              "op-finished",
+		StartedAt:       now.Add(-time.Minute),
+		RunningDuration: time.Second,
+	}
+
+	txn := c.db.Txn(true)
+	for _, op := range []*AsyncOperation{expired, finished} {
+		if err := txn.Insert("operations", op); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+	txn.Commit()
+
+	op, err := c.ReadAsyncOperation(expired.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if op != nil {
+		t.Errorf("expected expired operation not to be read, got: %+v", op)
+	}
+
+	if _, err := c.StartAsyncOperation(AsyncOperationRequest{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	txn = c.db.Txn(false)
+	defer txn.Abort()
+
+	for id, expected := range map[string]bool{
+		expired.ID:  false,
+		finished.ID: true,
+	} {
+		raw, err := txn.First("operations", "id", id)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if got := raw != nil; got != expected {
+			t.Errorf("expected operation %s stored to be %t, got: %t", id, expected, got)
+		}
+	}
+}
+
+func newFreshClient(t *testing.T) *Client {
+	t.Helper()
+
+	c, err := NewClient(WithFreshDatabase())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return c
+}
@@ -0,0 +1,94 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package backend
+
+import (
+	"sync"
+	"time"
+)
+
+// consistency simulates an eventually consistent API, where reads of a user
+// return the value from before its last write until the delay has elapsed.
+// Reads after a create return nil, and reads after a delete return the
+// deleted user.
+type consistency struct {
+	delay time.Duration
+
+	mu      sync.Mutex
+	pending map[string]pendingWrite
+}
+
+type pendingWrite struct {
+	visibleAt time.Time
+
+	// previous is the user as it was before the first pending write, or nil
+	// if the user did not exist.
+	previous *User
+}
+
+func newConsistency(delay time.Duration) *consistency {
+	if delay <= 0 {
+		return nil
+	}
+
+	return &consistency{
+		delay:   delay,
+		pending: make(map[string]pendingWrite),
+	}
+}
+
+// recordWrite starts or extends the window in which reads of the user are
+// stale. Writes within an existing window keep the original previous value.
+func (c *consistency) recordWrite(email string, previous *User) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if p, ok := c.pending[email]; ok && time.Now().Before(p.visibleAt) {
+		previous = p.previous
+	}
+
+	c.pending[email] = pendingWrite{
+		visibleAt: time.Now().Add(c.delay),
+		previous:  previous,
+	}
+}
+
+// view returns what a read of the user observes, given its current value.
+func (c *consistency) view(email string, current *User) *User {
+	if c == nil {
+		return current
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	p, ok := c.pending[email]
+	if !ok {
+		return current
+	}
+
+	if !time.Now().Before(p.visibleAt) {
+		delete(c.pending, email)
+
+		return current
+	}
+
+	return p.previous
+}
+
+// reset ends every window in which reads are stale.
+func (c *consistency) reset() {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	clear(c.pending)
+}
@@ -0,0 +1,86 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package backend
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWithReadAfterWriteDelay(t *testing.T) {
+	t.Parallel()
+
+	delay := 100 * time.Millisecond
+
+	c, err := NewClient(WithFreshDatabase(), WithReadAfterWriteDelay(delay))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := c.CreateUser(&User{Email: "ford@prefect.co", Name: "Ford Prefect", Age: 200}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got, err := c.ReadUser("ford@prefect.co")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got != nil {
+		t.Fatalf("expected user not to be visible right after create, got %+v", got)
+	}
+
+	// uniqueness is still enforced against the consistent view
+	if err := c.CreateUser(&User{Email: "ford@prefect.co", Name: "Ford Prefect", Age: 200}); err == nil {
+		t.Fatal("expected error creating duplicate user, got none")
+	}
+
+	time.Sleep(delay)
+
+	got, err = c.ReadUser("ford@prefect.co")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got == nil {
+		t.Fatal("expected user to be visible after the delay")
+	}
+
+	if err := c.UpdateUser(&User{Email: "ford@prefect.co", Name: "Arthur Dent", Age: 42}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got, err = c.ReadUser("ford@prefect.co")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got == nil || got.Name != "Ford Prefect" {
+		t.Fatalf("expected stale read right after update, got %+v", got)
+	}
+
+	time.Sleep(delay)
+
+	got, err = c.ReadUser("ford@prefect.co")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got == nil || got.Name != "Arthur Dent" {
+		t.Fatalf("expected updated user after the delay, got %+v", got)
+	}
+
+	if err := c.DeleteUser(got); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got, err = c.ReadUser("ford@prefect.co")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got == nil {
+		t.Fatal("expected deleted user to still be visible right after delete")
+	}
+}
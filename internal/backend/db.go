@@ -4,7 +4,11 @@
 package backend
 
 import (
+	"context"
 	"fmt"
+	"maps"
+	"os"
+	"slices"
 	"time"
 
 	"github.com/hashicorp/go-memdb"
@@ -18,21 +22,63 @@ type User struct {
 	Age        int
 	DateJoined string
 	Language   string
+
+	// Region is the name of the Region the user is in, which must exist, or
+	// empty if the user is in no region.
+	Region string
+
+	// Version starts at 1 and is incremented by every update. It allows
+	// conditional updates and deletes to detect concurrent modification.
+	Version int
+
+	// Tags are free-form key-value pairs. Every write replaces all of them.
+	Tags map[string]string
 }
 
 // Region represents an availability region in the database.
 type Region struct {
-	Name string
+	// Name must be unique, and is treated as the Region's UUID.
+	Name        string
+	Description string
+}
+
+// Group represents a named group of users in the database.
+type Group struct {
+	// Name must be unique, and is treated as the Group's UUID.
+	Name        string
+	Description string
+}
+
+// Membership represents a User belonging to a Group in the database. The
+// referenced Group and User must exist, and cannot be deleted while the
+// Membership exists.
+type Membership struct {
+	Group string
+	Email string
 }
 
 // A Client manages communication with the memdb.
 type Client struct {
-	db *memdb.MemDB
+	db          *memdb.MemDB
+	namespace   string
+	persistence *persistence
+	faults      *FaultInjector
+	consistency *consistency
+	journal     *Journal
+
+	// softDeleteRetention is how long deleted users are kept as tombstones.
+	// Zero deletes users outright.
+	softDeleteRetention time.Duration
+
+	limiter *rateLimiter
+	quotas  map[string]int
+
+	// remote is set for clients created with WithEndpoint, which have no
+	// database of their own.
+	remote *httpClient
 }
 
-var db *memdb.MemDB
-
-func init() {
+func newDB() (*memdb.MemDB, error) {
 	schema := &memdb.DBSchema{
 		Tables: map[string]*memdb.TableSchema{
 			"users": {
@@ -48,6 +94,12 @@ func init() {
 						Unique:  false,
 						Indexer: &memdb.IntFieldIndex{Field: "Age"},
 					},
+					"region": {
+						Name:         "region",
+						Unique:       false,
+						AllowMissing: true,
+						Indexer:      &memdb.StringFieldIndex{Field: "Region"},
+					},
 				},
 			},
 			"regions": {
@@ -60,13 +112,67 @@ func init() {
 					},
 				},
 			},
+			"groups": {
+				Name: "groups",
+				Indexes: map[string]*memdb.IndexSchema{
+					"id": {
+						Name:    "id",
+						Unique:  true,
+						Indexer: &memdb.StringFieldIndex{Field: "Name"},
+					},
+				},
+			},
+			"memberships": {
+				Name: "memberships",
+				Indexes: map[string]*memdb.IndexSchema{
+					"id": {
+						Name:   "id",
+						Unique: true,
+						Indexer: &memdb.CompoundIndex{
+							Indexes: []memdb.Indexer{
+								&memdb.StringFieldIndex{Field: "Group"},
+								&memdb.StringFieldIndex{Field: "Email"},
+							},
+						},
+					},
+					"group": {
+						Name:    "group",
+						Unique:  false,
+						Indexer: &memdb.StringFieldIndex{Field: "Group"},
+					},
+					"email": {
+						Name:    "email",
+						Unique:  false,
+						Indexer: &memdb.StringFieldIndex{Field: "Email"},
+					},
+				},
+			},
+			"operations": {
+				Name: "operations",
+				Indexes: map[string]*memdb.IndexSchema{
+					"id": {
+						Name:    "id",
+						Unique:  true,
+						Indexer: &memdb.StringFieldIndex{Field: "ID"},
+					},
+				},
+			},
+			"tombstones": {
+				Name: "tombstones",
+				Indexes: map[string]*memdb.IndexSchema{
+					"id": {
+						Name:    "id",
+						Unique:  true,
+						Indexer: &memdb.StringFieldIndex{Field: "Email"},
+					},
+				},
+			},
 		},
 	}
 
-	var err error
-	db, err = memdb.NewMemDB(schema)
+	db, err := memdb.NewMemDB(schema)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
 
 	// populate regions for use in data source
@@ -77,28 +183,257 @@ func init() {
 	for _, r := range regions {
 		err := txn.Insert("regions", r)
 		if err != nil {
-			panic(err)
+			return nil, err
 		}
 	}
 
 	txn.Commit()
+
+	return db, nil
 }
 
-// NewClient returns a new memdb client.
-func NewClient() (*Client, error) {
-	c := &Client{}
+func init() {
+	db, err := newDB()
+	if err != nil {
+		panic(err)
+	}
+
+	namespaces[DefaultNamespace] = db
+}
+
+// NewClient returns a new memdb client. Without options, the client shares
+// the database of the DefaultNamespace with every other client.
+func NewClient(opts ...Option) (*Client, error) {
+	var o clientOptions
+
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	c := &Client{
+		namespace:           o.namespace,
+		faults:              o.faults,
+		consistency:         newConsistency(o.readAfterWriteDelay),
+		softDeleteRetention: o.softDeleteRetention,
+		limiter:             newRateLimiter(o.rateLimit, o.rateBurst),
+		quotas:              o.quotas,
+	}
+
+	if err := validateQuotas(o.quotas); err != nil {
+		return nil, err
+	}
+
+	if c.faults == nil {
+		c.faults = namespaceFaultInjector(o.namespace)
+	}
+
+	if o.endpoint != "" {
+		remote, err := newHTTPClient(o.endpoint, o.httpClient, o.userAgent)
+		if err != nil {
+			return nil, err
+		}
+
+		c.remote = remote
+		c.consistency = nil
+
+		// Operations are journaled by the server, this journal stays empty.
+		c.journal = &Journal{}
+
+		return c, nil
+	}
+
+	if o.persistencePath == "" {
+		o.persistencePath = os.Getenv(PersistencePathEnvVar)
+	}
+
+	if o.persistencePath != "" {
+		c.persistence = &persistence{path: o.persistencePath}
+
+		// The file is the source of truth, so the client never shares its
+		// in-memory copy with other clients.
+		o.fresh = true
+	}
+
+	if o.fresh {
+		db, err := newDB()
+		if err != nil {
+			return nil, err
+		}
+
+		c.db = db
+		c.journal = &Journal{}
+
+		return c, nil
+	}
+
+	db, err := namespaceDB(o.namespace)
+	if err != nil {
+		return nil, err
+	}
 
 	c.db = db
+	c.journal = NamespaceJournal(o.namespace)
 
 	return c, nil
 }
 
+// Namespace returns the name of the namespace the client was created in.
+func (c *Client) Namespace() string {
+	return c.namespace
+}
+
+// WithContext returns a shallow copy of the client whose requests to an
+// endpoint given by WithEndpoint are sent with ctx, so that its deadline and
+// cancellation end them. Clients without an endpoint ignore ctx.
+func (c *Client) WithContext(ctx context.Context) *Client {
+	if c.remote == nil {
+		return c
+	}
+
+	remote := *c.remote
+	remote.ctx = ctx
+
+	clone := *c
+	clone.remote = &remote
+
+	return &clone
+}
+
+// CreateUser inserts a new user, erroring if a user with the same email
+// already exists, or with a *TombstoneError if a deleted user with the same
+// email can still be restored.
 func (c *Client) CreateUser(user *User) error {
+	return c.faulted(OperationCreateUser, func() error {
+		if c.remote != nil {
+			return c.remote.createUser(user)
+		}
+
+		return c.persisted(true, func() error {
+			return c.createUser(user)
+		})
+	})
+}
+
+// ReadUser returns the user with the given email, or nil if there is none.
+func (c *Client) ReadUser(email string) (*User, error) {
+	var user *User
+
+	err := c.faulted(OperationReadUser, func() error {
+		if c.remote != nil {
+			var err error
+			user, err = c.remote.readUser(email)
+
+			return err
+		}
+
+		return c.persisted(false, func() error {
+			var err error
+			user, err = c.readUser(email)
+			if err != nil {
+				return err
+			}
+
+			user = c.consistency.view(email, user)
+			c.journal.record(OperationReadUser, email, nil, clone(user))
+
+			return nil
+		})
+	})
+
+	return user, err
+}
+
+// UpdateUser overwrites the name, age, language and region of an existing
+// user and sets user.Version to the new version. An empty language keeps the
+// language of the user.
+func (c *Client) UpdateUser(user *User) error {
+	return c.faulted(OperationUpdateUser, func() error {
+		if c.remote != nil {
+			return c.remote.updateUser(user, nil)
+		}
+
+		return c.persisted(true, func() error {
+			return c.updateUser(user, nil)
+		})
+	})
+}
+
+// UpdateUserIfVersion is UpdateUser, but returns a *ConflictError without
+// updating if the stored user is not at the given version.
+func (c *Client) UpdateUserIfVersion(user *User, version int) error {
+	return c.faulted(OperationUpdateUser, func() error {
+		if c.remote != nil {
+			return c.remote.updateUser(user, &version)
+		}
+
+		return c.persisted(true, func() error {
+			return c.updateUser(user, &version)
+		})
+	})
+}
+
+// DeleteUser removes an existing user, keeping a Tombstone of it if the
+// client was created with WithSoftDelete.
+func (c *Client) DeleteUser(user *User) error {
+	return c.faulted(OperationDeleteUser, func() error {
+		if c.remote != nil {
+			return c.remote.deleteUser(user, nil)
+		}
+
+		return c.persisted(true, func() error {
+			return c.deleteUser(user, nil)
+		})
+	})
+}
+
+// DeleteUserIfVersion is DeleteUser, but returns a *ConflictError without
+// deleting if the stored user is not at the given version.
+func (c *Client) DeleteUserIfVersion(user *User, version int) error {
+	return c.faulted(OperationDeleteUser, func() error {
+		if c.remote != nil {
+			return c.remote.deleteUser(user, &version)
+		}
+
+		return c.persisted(true, func() error {
+			return c.deleteUser(user, &version)
+		})
+	})
+}
+
+// ReadRegions returns all regions.
+func (c *Client) ReadRegions() ([]*Region, error) {
+	var regions []*Region
+
+	err := c.faulted(OperationReadRegions, func() error {
+		if c.remote != nil {
+			var err error
+			regions, err = c.remote.readRegions()
+
+			return err
+		}
+
+		return c.persisted(false, func() error {
+			var err error
+			regions, err = c.readRegions()
+			if err != nil {
+				return err
+			}
+
+			c.journal.record(OperationReadRegions, "", nil, slices.Clone(regions))
+
+			return nil
+		})
+	})
+
+	return regions, err
+}
+
+func (c *Client) createUser(user *User) error {
 	txn := c.db.Txn(true)
 	defer txn.Abort()
 
 	// uniqueness: error if email already exists in db
-	existingUser, err := c.ReadUser(user.Email)
+	existingUser, err := c.readUser(user.Email)
 	if err != nil {
 		return fmt.Errorf("Error determining if user with email %s already exists in db: %s", user.Email, err)
 	}
@@ -107,21 +442,50 @@ func (c *Client) CreateUser(user *User) error {
 		return fmt.Errorf("Cannot create user: user already exists with email %s", user.Email)
 	}
 
+	tombstone, err := liveTombstone(txn, user.Email, true)
+	if err != nil {
+		return err
+	}
+
+	if tombstone != nil {
+		return &TombstoneError{
+			Email:     user.Email,
+			DeletedAt: tombstone.DeletedAt,
+			ExpiresAt: tombstone.ExpiresAt,
+		}
+	}
+
+	if err := checkRegion(txn, user); err != nil {
+		return fmt.Errorf("Cannot create user with email %s: %s", user.Email, err)
+	}
+
+	if err := c.checkQuota(txn, "users"); err != nil {
+		return err
+	}
+
 	user.DateJoined = time.Now().Format(time.RFC3339)
+	user.Version = 1
 	if user.Language == "" {
 		user.Language = "en"
 	}
 
-	if err := txn.Insert("users", user); err != nil {
+	// copy, as the caller may modify user after it is stored
+	stored := *user
+	stored.Tags = maps.Clone(user.Tags)
+
+	if err := txn.Insert("users", &stored); err != nil {
 		return err
 	}
 
 	txn.Commit()
 
+	c.consistency.recordWrite(user.Email, nil)
+	c.journal.record(OperationCreateUser, user.Email, nil, clone(user))
+
 	return nil
 }
 
-func (c *Client) ReadUser(email string) (*User, error) {
+func (c *Client) readUser(email string) (*User, error) {
 	// Create read-only transaction
 	txn := c.db.Txn(false)
 	defer txn.Abort()
@@ -142,7 +506,7 @@ func (c *Client) ReadUser(email string) (*User, error) {
 	return nil, nil
 }
 
-func (c *Client) UpdateUser(user *User) error {
+func (c *Client) updateUser(user *User, version *int) error {
 	txn := c.db.Txn(true)
 	defer txn.Abort()
 
@@ -152,32 +516,58 @@ func (c *Client) UpdateUser(user *User) error {
 		return err
 	}
 
-	p, ok := raw.(*User)
+	if raw == nil {
+		return fmt.Errorf("Cannot update user with email %s: email not in db", user.Email)
+	}
+
+	existing, ok := raw.(*User)
 	if !ok {
 		return fmt.Errorf("unexpected type %T while updating user", raw)
 	}
 
+	if version != nil && existing.Version != *version {
+		return &ConflictError{
+			Email:           user.Email,
+			ExpectedVersion: *version,
+			ActualVersion:   existing.Version,
+		}
+	}
+
+	// copy, as objects in memdb must not be modified in place
+	p := *existing
+	p.Version++
 	p.Name = user.Name
 	p.Age = user.Age
 	if user.Language != "" {
 		p.Language = user.Language
 	}
+	p.Region = user.Region
+	p.Tags = maps.Clone(user.Tags)
 
-	err = txn.Insert("users", p)
+	if err := checkRegion(txn, &p); err != nil {
+		return fmt.Errorf("Cannot update user with email %s: %s", user.Email, err)
+	}
+
+	err = txn.Insert("users", &p)
 	if err != nil {
 		return err
 	}
 
 	txn.Commit()
 
+	c.consistency.recordWrite(user.Email, existing)
+	c.journal.record(OperationUpdateUser, user.Email, clone(existing), clone(&p))
+
+	user.Version = p.Version
+
 	return nil
 }
 
-func (c *Client) DeleteUser(user *User) error {
+func (c *Client) deleteUser(user *User, version *int) error {
 	txn := c.db.Txn(true)
 	defer txn.Abort()
 
-	existingUser, err := c.ReadUser(user.Email)
+	existingUser, err := c.readUser(user.Email)
 	if err != nil {
 		return fmt.Errorf("Error determining if user with email %s exists in db: %s", user.Email, err)
 	}
@@ -186,17 +576,56 @@ func (c *Client) DeleteUser(user *User) error {
 		return fmt.Errorf("Cannot delete user with email %s: email not in db", user.Email)
 	}
 
+	if version != nil && existingUser.Version != *version {
+		return &ConflictError{
+			Email:           user.Email,
+			ExpectedVersion: *version,
+			ActualVersion:   existingUser.Version,
+		}
+	}
+
+	// referential integrity: error if the user is still a member of a group
+	membership, err := txn.First("memberships", "email", user.Email)
+	if err != nil {
+		return err
+	}
+
+	if membership != nil {
+		return &InUseError{
+			Kind:         "user",
+			Name:         user.Email,
+			ReferencedBy: "group membership",
+		}
+	}
+
 	err = txn.Delete("users", user)
 	if err != nil {
 		return err
 	}
 
+	if c.softDeleteRetention > 0 {
+		now := time.Now()
+
+		err = txn.Insert("tombstones", &Tombstone{
+			Email:     user.Email,
+			User:      existingUser,
+			DeletedAt: now,
+			ExpiresAt: now.Add(c.softDeleteRetention),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
 	txn.Commit()
 
+	c.consistency.recordWrite(user.Email, existingUser)
+	c.journal.record(OperationDeleteUser, user.Email, clone(existingUser), nil)
+
 	return nil
 }
 
-func (c *Client) ReadRegions() ([]*Region, error) {
+func (c *Client) readRegions() ([]*Region, error) {
 	regions := []*Region{}
 
 	txn := c.db.Txn(false)
@@ -0,0 +1,178 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package backend
+
+import (
+	"errors"
+	"maps"
+	"testing"
+)
+
+func TestClient_userVersion(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewClient(WithFreshDatabase())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	u := &User{Email: "ford@prefect.co", Name: "Ford Prefect", Age: 200}
+	if err := c.CreateUser(u); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if u.Version != 1 {
+		t.Errorf("expected version 1 after create, got %d", u.Version)
+	}
+
+	update := &User{Email: "ford@prefect.co", Name: "Ford Prefect", Age: 201}
+	if err := c.UpdateUser(update); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if update.Version != 2 {
+		t.Errorf("expected version 2 after update, got %d", update.Version)
+	}
+
+	got, err := c.ReadUser("ford@prefect.co")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got.Version != 2 || got.Age != 201 {
+		t.Errorf("unexpected user after update: %+v", got)
+	}
+}
+
+func TestClient_userTags(t *testing.T) {
+	t.Parallel()
+
+	c := newFreshClient(t)
+
+	tags := map[string]string{"team": "hitchhikers"}
+
+	u := &User{Email: "ford@prefect.co", Name: "Ford Prefect", Age: 200, Tags: tags}
+	if err := c.CreateUser(u); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// the stored tags must not change with the map of the caller
+	tags["team"] = "vogons"
+
+	got, err := c.ReadUser("ford@prefect.co")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !maps.Equal(got.Tags, map[string]string{"team": "hitchhikers"}) {
+		t.Errorf("unexpected tags after create: %v", got.Tags)
+	}
+
+	update := &User{Email: "ford@prefect.co", Name: "Ford Prefect", Age: 200, Tags: map[string]string{"planet": "betelgeuse"}}
+	if err := c.UpdateUser(update); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got, err = c.ReadUser("ford@prefect.co")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !maps.Equal(got.Tags, map[string]string{"planet": "betelgeuse"}) {
+		t.Errorf("expected update to replace tags, got: %v", got.Tags)
+	}
+
+	err = c.MutateUser("ford@prefect.co", func(u *User) {
+		u.Tags["planet"] = "earth"
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !maps.Equal(got.Tags, map[string]string{"planet": "betelgeuse"}) {
+		t.Errorf("expected mutate not to modify tags in place, got: %v", got.Tags)
+	}
+
+	if err := c.UpdateUser(&User{Email: "ford@prefect.co", Name: "Ford Prefect", Age: 200}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got, err = c.ReadUser("ford@prefect.co")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(got.Tags) != 0 {
+		t.Errorf("expected update without tags to remove them, got: %v", got.Tags)
+	}
+}
+
+func TestClient_UpdateUserIfVersion(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewClient(WithFreshDatabase())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := c.CreateUser(&User{Email: "ford@prefect.co", Name: "Ford Prefect", Age: 200}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := c.UpdateUserIfVersion(&User{Email: "ford@prefect.co", Name: "Arthur Dent", Age: 42}, 1); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	err = c.UpdateUserIfVersion(&User{Email: "ford@prefect.co", Name: "Zaphod Beeblebrox", Age: 42}, 1)
+
+	var conflictErr *ConflictError
+	if !errors.As(err, &conflictErr) {
+		t.Fatalf("expected ConflictError, got: %v", err)
+	}
+
+	if conflictErr.ExpectedVersion != 1 || conflictErr.ActualVersion != 2 {
+		t.Errorf("unexpected conflict: %+v", conflictErr)
+	}
+
+	got, err := c.ReadUser("ford@prefect.co")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got.Name != "Arthur Dent" {
+		t.Errorf("expected conflicting update not to be applied, got %+v", got)
+	}
+}
+
+func TestClient_DeleteUserIfVersion(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewClient(WithFreshDatabase())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	u := &User{Email: "ford@prefect.co", Name: "Ford Prefect", Age: 200}
+	if err := c.CreateUser(u); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var conflictErr *ConflictError
+	if err := c.DeleteUserIfVersion(u, 2); !errors.As(err, &conflictErr) {
+		t.Fatalf("expected ConflictError, got: %v", err)
+	}
+
+	if err := c.DeleteUserIfVersion(u, 1); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got, err := c.ReadUser("ford@prefect.co")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got != nil {
+		t.Errorf("expected user to be deleted, got %+v", got)
+	}
+}
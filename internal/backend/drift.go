@@ -0,0 +1,176 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package backend
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"time"
+)
+
+// The drift API changes records behind Terraform's back, as another user of
+// the API would, so that tests can verify that refresh detects the changes.
+// Drift does not start a read-after-write delay on eventually consistent
+// clients. Its operations are journaled like other operations, but faults
+// cannot be injected into them.
+const (
+	OperationMutateUser          Operation = "MutateUser"
+	OperationDeleteUserOutOfBand Operation = "DeleteUserOutOfBand"
+	OperationInjectUser          Operation = "InjectUser"
+)
+
+// errDriftRemote is returned by the drift API of clients created with
+// WithEndpoint, which should instead drift the records of the server.
+var errDriftRemote = errors.New("Cannot drift records through a client created with WithEndpoint: use a client of the server instead")
+
+// MutateUser applies mutate to a copy of the user with the given email and
+// stores the result as the next version of the user. The email cannot be
+// changed.
+func (c *Client) MutateUser(email string, mutate func(*User)) error {
+	if c.remote != nil {
+		return errDriftRemote
+	}
+
+	return c.persisted(true, func() error {
+		return c.mutateUser(email, mutate)
+	})
+}
+
+// DeleteUserOutOfBand removes the user with the given email regardless of its
+// version, also removing the user from every group.
+func (c *Client) DeleteUserOutOfBand(email string) error {
+	if c.remote != nil {
+		return errDriftRemote
+	}
+
+	return c.persisted(true, func() error {
+		return c.deleteUserOutOfBand(email)
+	})
+}
+
+// InjectUser stores the user as given, replacing any existing user with the
+// same email. Unlike CreateUser, no defaults are applied, except that
+// user.Version is set to the version after the existing user's, or 1, and
+// an empty DateJoined is set to the current time.
+func (c *Client) InjectUser(user *User) error {
+	if c.remote != nil {
+		return errDriftRemote
+	}
+
+	return c.persisted(true, func() error {
+		return c.injectUser(user)
+	})
+}
+
+func (c *Client) mutateUser(email string, mutate func(*User)) error {
+	txn := c.db.Txn(true)
+	defer txn.Abort()
+
+	raw, err := txn.First("users", "id", email)
+	if err != nil {
+		return err
+	}
+
+	if raw == nil {
+		return fmt.Errorf("Cannot mutate user with email %s: email not in db", email)
+	}
+
+	existing, ok := raw.(*User)
+	if !ok {
+		return fmt.Errorf("unexpected type %T while mutating user", raw)
+	}
+
+	// copy, as objects in memdb must not be modified in place
+	p := *existing
+	p.Tags = maps.Clone(existing.Tags)
+	mutate(&p)
+	p.Email = existing.Email
+	p.Version = existing.Version + 1
+
+	if err := txn.Insert("users", &p); err != nil {
+		return err
+	}
+
+	txn.Commit()
+
+	c.journal.record(OperationMutateUser, email, clone(existing), clone(&p))
+
+	return nil
+}
+
+func (c *Client) deleteUserOutOfBand(email string) error {
+	txn := c.db.Txn(true)
+	defer txn.Abort()
+
+	raw, err := txn.First("users", "id", email)
+	if err != nil {
+		return err
+	}
+
+	if raw == nil {
+		return fmt.Errorf("Cannot delete user with email %s: email not in db", email)
+	}
+
+	existing, ok := raw.(*User)
+	if !ok {
+		return fmt.Errorf("unexpected type %T while deleting user", raw)
+	}
+
+	if _, err := txn.DeleteAll("memberships", "email", email); err != nil {
+		return err
+	}
+
+	if err := txn.Delete("users", existing); err != nil {
+		return err
+	}
+
+	txn.Commit()
+
+	c.journal.record(OperationDeleteUserOutOfBand, email, clone(existing), nil)
+
+	return nil
+}
+
+func (c *Client) injectUser(user *User) error {
+	txn := c.db.Txn(true)
+	defer txn.Abort()
+
+	raw, err := txn.First("users", "id", user.Email)
+	if err != nil {
+		return err
+	}
+
+	var existing *User
+	if raw != nil {
+		var ok bool
+		existing, ok = raw.(*User)
+		if !ok {
+			return fmt.Errorf("unexpected type %T while injecting user", raw)
+		}
+	}
+
+	p := *user
+	p.Tags = maps.Clone(user.Tags)
+	p.Version = 1
+	if existing != nil {
+		p.Version = existing.Version + 1
+	}
+	if p.DateJoined == "" {
+		p.DateJoined = time.Now().Format(time.RFC3339)
+	}
+
+	if err := txn.Insert("users", &p); err != nil {
+		return err
+	}
+
+	txn.Commit()
+
+	c.journal.record(OperationInjectUser, user.Email, clone(existing), clone(&p))
+
+	user.Version = p.Version
+	user.DateJoined = p.DateJoined
+
+	return nil
+}
@@ -0,0 +1,135 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package backend
+
+import (
+	"testing"
+)
+
+func TestClient_MutateUser(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewClient(WithFreshDatabase())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := c.MutateUser("ford@prefect.co", func(u *User) {}); err == nil {
+		t.Fatal("expected error mutating missing user, got none")
+	}
+
+	if err := c.CreateUser(&User{Email: "ford@prefect.co", Name: "Ford Prefect", Age: 200}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	err = c.MutateUser("ford@prefect.co", func(u *User) {
+		u.Email = "ix@betelgeuse.co"
+		u.Name = "Ix"
+		u.Language = "es"
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	u, err := c.ReadUser("ford@prefect.co")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if u == nil || u.Name != "Ix" || u.Language != "es" || u.Age != 200 || u.Version != 2 {
+		t.Errorf("unexpected user: %+v", u)
+	}
+
+	if entries := c.Journal().Query(JournalQuery{Operation: OperationMutateUser}); len(entries) != 1 {
+		t.Errorf("expected 1 journal entry, got: %+v", entries)
+	}
+}
+
+func TestClient_DeleteUserOutOfBand(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewClient(WithFreshDatabase())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := c.DeleteUserOutOfBand("ford@prefect.co"); err == nil {
+		t.Fatal("expected error deleting missing user, got none")
+	}
+
+	if err := c.CreateUser(&User{Email: "ford@prefect.co", Name: "Ford Prefect", Age: 200}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := c.CreateGroup(&Group{Name: "hitchhikers"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := c.CreateMembership(&Membership{Group: "hitchhikers", Email: "ford@prefect.co"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := c.DeleteUserOutOfBand("ford@prefect.co"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	u, err := c.ReadUser("ford@prefect.co")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if u != nil {
+		t.Errorf("expected user to be deleted, got: %+v", u)
+	}
+
+	m, err := c.ReadMembership("hitchhikers", "ford@prefect.co")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if m != nil {
+		t.Errorf("expected membership to be deleted, got: %+v", m)
+	}
+}
+
+func TestClient_InjectUser(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewClient(WithFreshDatabase())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	user := &User{Email: "ford@prefect.co", Name: "Ford Prefect", Age: 200}
+	if err := c.InjectUser(user); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if user.Version != 1 || user.DateJoined == "" || user.Language != "" {
+		t.Errorf("unexpected injected user: %+v", user)
+	}
+
+	if err := c.InjectUser(&User{Email: "ford@prefect.co", Name: "Ix", DateJoined: "1979-10-12T00:00:00Z"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	u, err := c.ReadUser("ford@prefect.co")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if u == nil || u.Name != "Ix" || u.Age != 0 || u.DateJoined != "1979-10-12T00:00:00Z" || u.Version != 2 {
+		t.Errorf("unexpected user: %+v", u)
+	}
+}
+
+func TestClient_driftRemote(t *testing.T) {
+	t.Parallel()
+
+	c := newTestServer(t, nil)
+
+	if err := c.InjectUser(&User{Email: "ford@prefect.co"}); err == nil {
+		t.Error("expected error drifting through a remote client, got none")
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package backend
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ConflictError is returned by conditional operations when the stored user
+// has been modified since the expected version was read.
+type ConflictError struct {
+	Email           string
+	ExpectedVersion int
+	ActualVersion   int
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("Conflict modifying user with email %s: expected version %d, found version %d", e.Email, e.ExpectedVersion, e.ActualVersion)
+}
+
+// InUseError is returned when deleting a record that is still referenced by
+// another record.
+type InUseError struct {
+	Kind         string
+	Name         string
+	ReferencedBy string
+}
+
+func (e *InUseError) Error() string {
+	return fmt.Sprintf("Cannot delete %s %s: still referenced by %s", e.Kind, e.Name, e.ReferencedBy)
+}
+
+// TombstoneError is returned when creating a user with the email of a deleted
+// user that can still be restored.
+type TombstoneError struct {
+	Email     string
+	DeletedAt time.Time
+	ExpiresAt time.Time
+}
+
+func (e *TombstoneError) Error() string {
+	return fmt.Sprintf("Cannot create user: user with email %s was deleted at %s and can be restored until %s", e.Email, e.DeletedAt.Format(time.RFC3339), e.ExpiresAt.Format(time.RFC3339))
+}
+
+// ThrottlingError is returned when an operation exceeds the rate limit of
+// the client.
+type ThrottlingError struct {
+	Operation Operation
+
+	// RetryAfter is how long to wait before the operation is allowed.
+	RetryAfter time.Duration
+}
+
+func (e *ThrottlingError) Error() string {
+	return fmt.Sprintf("Rate limit exceeded for %s: retry after %s", e.Operation, e.RetryAfter)
+}
+
+// QuotaError is returned when creating a record would exceed the quota of
+// its table.
+type QuotaError struct {
+	Table string
+	Limit int
+
+	// RetryAfter is how long to wait before trying again, in case records are
+	// deleted in the meantime. Unlike a rate limit, a quota does not lift by
+	// itself, so callers should not retry automatically.
+	RetryAfter time.Duration
+}
+
+func (e *QuotaError) Error() string {
+	return fmt.Sprintf("Quota exceeded for %s: limited to %d, retry after %s", e.Table, e.Limit, e.RetryAfter)
+}
+
+// RetryAfter returns the retry-after hint of a *ThrottlingError, and whether
+// err is one. A *QuotaError is not retryable, as it persists until records are
+// deleted.
+func RetryAfter(err error) (time.Duration, bool) {
+	var throttlingErr *ThrottlingError
+	if errors.As(err, &throttlingErr) {
+		return throttlingErr.RetryAfter, true
+	}
+
+	return 0, false
+}
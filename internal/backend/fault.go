@@ -0,0 +1,234 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package backend
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Operation names a Client method in journal entries and FaultRules.
+type Operation string
+
+const (
+	OperationCreateUser  Operation = "CreateUser"
+	OperationReadUser    Operation = "ReadUser"
+	OperationUpdateUser  Operation = "UpdateUser"
+	OperationDeleteUser  Operation = "DeleteUser"
+	OperationListUsers   Operation = "ListUsers"
+	OperationReadRegions Operation = "ReadRegions"
+
+	OperationCreateRegion Operation = "CreateRegion"
+	OperationReadRegion   Operation = "ReadRegion"
+	OperationUpdateRegion Operation = "UpdateRegion"
+	OperationDeleteRegion Operation = "DeleteRegion"
+
+	OperationRestoreUser   Operation = "RestoreUser"
+	OperationReadTombstone Operation = "ReadTombstone"
+
+	OperationCreateGroup      Operation = "CreateGroup"
+	OperationReadGroup        Operation = "ReadGroup"
+	OperationUpdateGroup      Operation = "UpdateGroup"
+	OperationDeleteGroup      Operation = "DeleteGroup"
+	OperationCreateMembership Operation = "CreateMembership"
+	OperationReadMembership   Operation = "ReadMembership"
+	OperationDeleteMembership Operation = "DeleteMembership"
+
+	OperationStartAsyncOperation Operation = "StartAsyncOperation"
+	OperationReadAsyncOperation  Operation = "ReadAsyncOperation"
+)
+
+// Operations returns every Operation that faults can be injected into.
+func Operations() []Operation {
+	return []Operation{
+		OperationCreateUser,
+		OperationReadUser,
+		OperationUpdateUser,
+		OperationDeleteUser,
+		OperationListUsers,
+		OperationReadRegions,
+		OperationCreateRegion,
+		OperationReadRegion,
+		OperationUpdateRegion,
+		OperationDeleteRegion,
+		OperationRestoreUser,
+		OperationReadTombstone,
+		OperationCreateGroup,
+		OperationReadGroup,
+		OperationUpdateGroup,
+		OperationDeleteGroup,
+		OperationCreateMembership,
+		OperationReadMembership,
+		OperationDeleteMembership,
+		OperationStartAsyncOperation,
+		OperationReadAsyncOperation,
+	}
+}
+
+// FaultRule describes a fault to inject into calls of an Operation.
+type FaultRule struct {
+	Operation Operation
+
+	// Error is the message of the InjectedFaultError returned by the call.
+	// Leaving it empty only applies Latency.
+	Error string
+
+	// Latency is slept before the call is made.
+	Latency time.Duration
+
+	// Call restricts the rule to the Nth call of Operation, counting from 1.
+	// Zero applies the rule to every call.
+	Call int
+
+	// AfterCommit performs the operation before returning the error, which
+	// simulates a request that succeeded but whose response was lost.
+	AfterCommit bool
+}
+
+// InjectedFaultError is returned by a Client operation that failed because
+// of a FaultRule.
+type InjectedFaultError struct {
+	Operation Operation
+	Call      int
+	Message   string
+}
+
+func (e *InjectedFaultError) Error() string {
+	return fmt.Sprintf("injected fault in %s (call %d): %s", e.Operation, e.Call, e.Message)
+}
+
+// A FaultInjector holds the FaultRules applied by the clients it is given to,
+// counting the calls made to each Operation. It is safe for concurrent use.
+type FaultInjector struct {
+	mu    sync.Mutex
+	rules []FaultRule
+	calls map[Operation]int
+}
+
+// NewFaultInjector returns a FaultInjector with the given rules.
+func NewFaultInjector(rules ...FaultRule) *FaultInjector {
+	return &FaultInjector{
+		rules: rules,
+		calls: make(map[Operation]int),
+	}
+}
+
+// AddRule adds a rule, which applies from the next call onwards.
+func (f *FaultInjector) AddRule(rule FaultRule) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.rules = append(f.rules, rule)
+}
+
+// Reset removes all rules and call counts.
+func (f *FaultInjector) Reset() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.rules = nil
+	f.calls = make(map[Operation]int)
+}
+
+// Calls returns the number of calls made to the Operation so far.
+func (f *FaultInjector) Calls(op Operation) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.calls[op]
+}
+
+// next records a call to the Operation and returns the latency to apply and
+// the first failing rule that matches it, if any.
+func (f *FaultInjector) next(op Operation) (time.Duration, *FaultRule, int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.calls[op]++
+	call := f.calls[op]
+
+	var latency time.Duration
+	var failure *FaultRule
+
+	for i := range f.rules {
+		rule := f.rules[i]
+
+		if rule.Operation != op {
+			continue
+		}
+
+		if rule.Call != 0 && rule.Call != call {
+			continue
+		}
+
+		latency += rule.Latency
+
+		if failure == nil && rule.Error != "" {
+			failure = &rule
+		}
+	}
+
+	return latency, failure, call
+}
+
+var faultInjectors = map[string]*FaultInjector{}
+
+// SetFaultInjector registers the FaultInjector used by clients subsequently
+// created in the namespace without WithFaultInjector. This allows injecting
+// faults into providers that do not expose fault configuration. A nil
+// FaultInjector removes the registration.
+func SetFaultInjector(namespace string, f *FaultInjector) {
+	namespacesMu.Lock()
+	defer namespacesMu.Unlock()
+
+	if f == nil {
+		delete(faultInjectors, namespace)
+
+		return
+	}
+
+	faultInjectors[namespace] = f
+}
+
+func namespaceFaultInjector(namespace string) *FaultInjector {
+	namespacesMu.Lock()
+	defer namespacesMu.Unlock()
+
+	return faultInjectors[namespace]
+}
+
+// faulted runs fn, applying the rate limit of the client, then the latency
+// and failures of any matching rules of the client's FaultInjector.
+func (c *Client) faulted(op Operation, fn func() error) error {
+	if err := c.throttle(op); err != nil {
+		return err
+	}
+
+	if c.faults == nil {
+		return fn()
+	}
+
+	latency, rule, call := c.faults.next(op)
+
+	if latency > 0 {
+		time.Sleep(latency)
+	}
+
+	if rule == nil {
+		return fn()
+	}
+
+	if rule.AfterCommit {
+		if err := fn(); err != nil {
+			return err
+		}
+	}
+
+	return &InjectedFaultError{
+		Operation: op,
+		Call:      call,
+		Message:   rule.Error,
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package backend
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestFaultInjector_everyCall(t *testing.T) {
+	t.Parallel()
+
+	faults := NewFaultInjector(FaultRule{
+		Operation: OperationCreateUser,
+		Error:     "service unavailable",
+	})
+
+	c, err := NewClient(WithFreshDatabase(), WithFaultInjector(faults))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	err = c.CreateUser(&User{Email: "ford@prefect.co", Name: "Ford Prefect", Age: 200})
+
+	var faultErr *InjectedFaultError
+	if !errors.As(err, &faultErr) {
+		t.Fatalf("expected InjectedFaultError, got: %v", err)
+	}
+
+	if faultErr.Operation != OperationCreateUser || faultErr.Call != 1 {
+		t.Errorf("unexpected fault: %+v", faultErr)
+	}
+
+	got, err := c.ReadUser("ford@prefect.co")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got != nil {
+		t.Errorf("expected failed create not to be committed, got %+v", got)
+	}
+}
+
+func TestFaultInjector_nthCall(t *testing.T) {
+	t.Parallel()
+
+	faults := NewFaultInjector(FaultRule{
+		Operation: OperationReadUser,
+		Error:     "timeout",
+		Call:      2,
+	})
+
+	c, err := NewClient(WithFreshDatabase(), WithFaultInjector(faults))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for call, expectErr := range []bool{false, true, false} {
+		_, err := c.ReadUser("ford@prefect.co")
+
+		if expectErr && err == nil {
+			t.Errorf("call %d: expected error, got none", call+1)
+		}
+
+		if !expectErr && err != nil {
+			t.Errorf("call %d: unexpected error: %s", call+1, err)
+		}
+	}
+
+	if got := faults.Calls(OperationReadUser); got != 3 {
+		t.Errorf("expected 3 recorded calls, got %d", got)
+	}
+}
+
+func TestFaultInjector_afterCommit(t *testing.T) {
+	t.Parallel()
+
+	faults := NewFaultInjector(FaultRule{
+		Operation:   OperationCreateUser,
+		Error:       "connection reset",
+		AfterCommit: true,
+	})
+
+	c, err := NewClient(WithFreshDatabase(), WithFaultInjector(faults))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	err = c.CreateUser(&User{Email: "ford@prefect.co", Name: "Ford Prefect", Age: 200})
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	got, err := c.ReadUser("ford@prefect.co")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got == nil {
+		t.Error("expected create to be committed before the fault was returned")
+	}
+}
+
+func TestFaultInjector_latency(t *testing.T) {
+	t.Parallel()
+
+	faults := NewFaultInjector(FaultRule{
+		Operation: OperationReadRegions,
+		Latency:   50 * time.Millisecond,
+	})
+
+	c, err := NewClient(WithFreshDatabase(), WithFaultInjector(faults))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	start := time.Now()
+
+	regions, err := c.ReadRegions()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Errorf("expected at least 50ms of latency, got %s", elapsed)
+	}
+
+	if len(regions) != 3 {
+		t.Errorf("expected 3 regions, got %d", len(regions))
+	}
+}
+
+func TestSetFaultInjector(t *testing.T) {
+	t.Parallel()
+
+	namespace := t.Name()
+
+	SetFaultInjector(namespace, NewFaultInjector(FaultRule{
+		Operation: OperationDeleteUser,
+		Error:     "forbidden",
+	}))
+
+	c, err := NewClient(WithNamespace(namespace))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := c.DeleteUser(&User{Email: "ford@prefect.co"}); err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	SetFaultInjector(namespace, nil)
+
+	c, err = NewClient(WithNamespace(namespace))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var faultErr *InjectedFaultError
+	if err := c.DeleteUser(&User{Email: "ford@prefect.co"}); errors.As(err, &faultErr) {
+		t.Fatalf("expected fault injector to be removed, got: %s", err)
+	}
+}
@@ -0,0 +1,352 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package backend
+
+import (
+	"fmt"
+)
+
+// CreateGroup inserts a new group, erroring if a group with the same name
+// already exists.
+func (c *Client) CreateGroup(group *Group) error {
+	return c.faulted(OperationCreateGroup, func() error {
+		if c.remote != nil {
+			return c.remote.createGroup(group)
+		}
+
+		return c.persisted(true, func() error {
+			return c.createGroup(group)
+		})
+	})
+}
+
+// ReadGroup returns the group with the given name, or nil if there is none.
+func (c *Client) ReadGroup(name string) (*Group, error) {
+	var group *Group
+
+	err := c.faulted(OperationReadGroup, func() error {
+		if c.remote != nil {
+			var err error
+			group, err = c.remote.readGroup(name)
+
+			return err
+		}
+
+		return c.persisted(false, func() error {
+			var err error
+			group, err = c.readGroup(name)
+			if err != nil {
+				return err
+			}
+
+			c.journal.record(OperationReadGroup, name, nil, clone(group))
+
+			return nil
+		})
+	})
+
+	return group, err
+}
+
+// UpdateGroup overwrites the description of an existing group.
+func (c *Client) UpdateGroup(group *Group) error {
+	return c.faulted(OperationUpdateGroup, func() error {
+		if c.remote != nil {
+			return c.remote.updateGroup(group)
+		}
+
+		return c.persisted(true, func() error {
+			return c.updateGroup(group)
+		})
+	})
+}
+
+// DeleteGroup removes an existing group, erroring with an *InUseError if it
+// still has members.
+func (c *Client) DeleteGroup(group *Group) error {
+	return c.faulted(OperationDeleteGroup, func() error {
+		if c.remote != nil {
+			return c.remote.deleteGroup(group)
+		}
+
+		return c.persisted(true, func() error {
+			return c.deleteGroup(group)
+		})
+	})
+}
+
+// CreateMembership adds an existing user to an existing group.
+func (c *Client) CreateMembership(membership *Membership) error {
+	return c.faulted(OperationCreateMembership, func() error {
+		if c.remote != nil {
+			return c.remote.createMembership(membership)
+		}
+
+		return c.persisted(true, func() error {
+			return c.createMembership(membership)
+		})
+	})
+}
+
+// ReadMembership returns the membership of the user in the group, or nil if
+// there is none.
+func (c *Client) ReadMembership(group string, email string) (*Membership, error) {
+	var membership *Membership
+
+	err := c.faulted(OperationReadMembership, func() error {
+		if c.remote != nil {
+			var err error
+			membership, err = c.remote.readMembership(group, email)
+
+			return err
+		}
+
+		return c.persisted(false, func() error {
+			var err error
+			membership, err = c.readMembership(group, email)
+			if err != nil {
+				return err
+			}
+
+			c.journal.record(OperationReadMembership, group+"/"+email, nil, clone(membership))
+
+			return nil
+		})
+	})
+
+	return membership, err
+}
+
+// DeleteMembership removes the user from the group.
+func (c *Client) DeleteMembership(membership *Membership) error {
+	return c.faulted(OperationDeleteMembership, func() error {
+		if c.remote != nil {
+			return c.remote.deleteMembership(membership)
+		}
+
+		return c.persisted(true, func() error {
+			return c.deleteMembership(membership)
+		})
+	})
+}
+
+func (c *Client) createGroup(group *Group) error {
+	txn := c.db.Txn(true)
+	defer txn.Abort()
+
+	// uniqueness: error if name already exists in db
+	existing, err := txn.First("groups", "id", group.Name)
+	if err != nil {
+		return fmt.Errorf("Error determining if group %s already exists in db: %s", group.Name, err)
+	}
+
+	if existing != nil {
+		return fmt.Errorf("Cannot create group: group already exists with name %s", group.Name)
+	}
+
+	if err := c.checkQuota(txn, "groups"); err != nil {
+		return err
+	}
+
+	// copy, as the caller may modify group after it is stored
+	if err := txn.Insert("groups", clone(group)); err != nil {
+		return err
+	}
+
+	txn.Commit()
+
+	c.journal.record(OperationCreateGroup, group.Name, nil, clone(group))
+
+	return nil
+}
+
+func (c *Client) readGroup(name string) (*Group, error) {
+	txn := c.db.Txn(false)
+	defer txn.Abort()
+
+	raw, err := txn.First("groups", "id", name)
+	if err != nil {
+		return nil, err
+	}
+
+	if raw == nil {
+		return nil, nil
+	}
+
+	g, ok := raw.(*Group)
+	if !ok {
+		return nil, fmt.Errorf("unexpected type %T while reading group", raw)
+	}
+
+	return g, nil
+}
+
+func (c *Client) updateGroup(group *Group) error {
+	txn := c.db.Txn(true)
+	defer txn.Abort()
+
+	raw, err := txn.First("groups", "id", group.Name)
+	if err != nil {
+		return err
+	}
+
+	if raw == nil {
+		return fmt.Errorf("Cannot update group %s: name not in db", group.Name)
+	}
+
+	existing, ok := raw.(*Group)
+	if !ok {
+		return fmt.Errorf("unexpected type %T while updating group", raw)
+	}
+
+	// copy, as objects in memdb must not be modified in place
+	g := *existing
+	g.Description = group.Description
+
+	if err := txn.Insert("groups", &g); err != nil {
+		return err
+	}
+
+	txn.Commit()
+
+	c.journal.record(OperationUpdateGroup, group.Name, clone(existing), clone(&g))
+
+	return nil
+}
+
+func (c *Client) deleteGroup(group *Group) error {
+	txn := c.db.Txn(true)
+	defer txn.Abort()
+
+	raw, err := txn.First("groups", "id", group.Name)
+	if err != nil {
+		return fmt.Errorf("Error determining if group %s exists in db: %s", group.Name, err)
+	}
+
+	if raw == nil {
+		return fmt.Errorf("Cannot delete group %s: name not in db", group.Name)
+	}
+
+	existing, ok := raw.(*Group)
+	if !ok {
+		return fmt.Errorf("unexpected type %T while deleting group", raw)
+	}
+
+	// referential integrity: error if the group still has members
+	membership, err := txn.First("memberships", "group", group.Name)
+	if err != nil {
+		return err
+	}
+
+	if membership != nil {
+		return &InUseError{
+			Kind:         "group",
+			Name:         group.Name,
+			ReferencedBy: "group membership",
+		}
+	}
+
+	if err := txn.Delete("groups", existing); err != nil {
+		return err
+	}
+
+	txn.Commit()
+
+	c.journal.record(OperationDeleteGroup, group.Name, clone(existing), nil)
+
+	return nil
+}
+
+func (c *Client) createMembership(membership *Membership) error {
+	txn := c.db.Txn(true)
+	defer txn.Abort()
+
+	// referential integrity: error if the group or user do not exist
+	group, err := txn.First("groups", "id", membership.Group)
+	if err != nil {
+		return err
+	}
+
+	if group == nil {
+		return fmt.Errorf("Cannot create membership: group %s not in db", membership.Group)
+	}
+
+	user, err := txn.First("users", "id", membership.Email)
+	if err != nil {
+		return err
+	}
+
+	if user == nil {
+		return fmt.Errorf("Cannot create membership: user with email %s not in db", membership.Email)
+	}
+
+	existing, err := txn.First("memberships", "id", membership.Group, membership.Email)
+	if err != nil {
+		return err
+	}
+
+	if existing != nil {
+		return fmt.Errorf("Cannot create membership: user with email %s is already a member of group %s", membership.Email, membership.Group)
+	}
+
+	if err := c.checkQuota(txn, "memberships"); err != nil {
+		return err
+	}
+
+	// copy, as the caller may modify membership after it is stored
+	if err := txn.Insert("memberships", clone(membership)); err != nil {
+		return err
+	}
+
+	txn.Commit()
+
+	c.journal.record(OperationCreateMembership, membership.Group+"/"+membership.Email, nil, clone(membership))
+
+	return nil
+}
+
+func (c *Client) readMembership(group string, email string) (*Membership, error) {
+	txn := c.db.Txn(false)
+	defer txn.Abort()
+
+	raw, err := txn.First("memberships", "id", group, email)
+	if err != nil {
+		return nil, err
+	}
+
+	if raw == nil {
+		return nil, nil
+	}
+
+	m, ok := raw.(*Membership)
+	if !ok {
+		return nil, fmt.Errorf("unexpected type %T while reading membership", raw)
+	}
+
+	return m, nil
+}
+
+func (c *Client) deleteMembership(membership *Membership) error {
+	txn := c.db.Txn(true)
+	defer txn.Abort()
+
+	existing, err := txn.First("memberships", "id", membership.Group, membership.Email)
+	if err != nil {
+		return err
+	}
+
+	if existing == nil {
+		return fmt.Errorf("Cannot delete membership: user with email %s is not a member of group %s", membership.Email, membership.Group)
+	}
+
+	if err := txn.Delete("memberships", existing); err != nil {
+		return err
+	}
+
+	txn.Commit()
+
+	c.journal.record(OperationDeleteMembership, membership.Group+"/"+membership.Email, clone(membership), nil)
+
+	return nil
+}
@@ -0,0 +1,93 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package backend
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestClient_groupMembership(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewClient(WithFreshDatabase())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	membership := &Membership{Group: "hitchhikers", Email: "ford@prefect.co"}
+
+	if err := c.CreateMembership(membership); err == nil {
+		t.Fatal("expected error creating membership of missing group, got none")
+	}
+
+	if err := c.CreateGroup(&Group{Name: "hitchhikers"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := c.CreateMembership(membership); err == nil {
+		t.Fatal("expected error creating membership of missing user, got none")
+	}
+
+	user := &User{Email: "ford@prefect.co", Name: "Ford Prefect", Age: 200}
+	if err := c.CreateUser(user); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := c.CreateMembership(membership); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var inUseErr *InUseError
+
+	if err := c.DeleteGroup(&Group{Name: "hitchhikers"}); !errors.As(err, &inUseErr) {
+		t.Errorf("expected InUseError deleting group with members, got: %v", err)
+	}
+
+	if err := c.DeleteUser(user); !errors.As(err, &inUseErr) {
+		t.Errorf("expected InUseError deleting user with memberships, got: %v", err)
+	}
+
+	if err := c.DeleteMembership(membership); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := c.DeleteGroup(&Group{Name: "hitchhikers"}); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	if err := c.DeleteUser(user); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
+
+func TestClient_UpdateGroup(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewClient(WithFreshDatabase())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := c.CreateGroup(&Group{Name: "hitchhikers", Description: "Hitchhikers"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := c.CreateGroup(&Group{Name: "hitchhikers"}); err == nil {
+		t.Fatal("expected error creating duplicate group, got none")
+	}
+
+	if err := c.UpdateGroup(&Group{Name: "hitchhikers", Description: "Guide users"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got, err := c.ReadGroup("hitchhikers")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got == nil || got.Description != "Guide users" {
+		t.Errorf("unexpected group after update: %+v", got)
+	}
+}
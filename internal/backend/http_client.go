@@ -0,0 +1,489 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package backend
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultUserAgent is the User-Agent header sent by clients created with
+// WithEndpoint but without WithUserAgent.
+const DefaultUserAgent = "terraform-provider-corner-backend"
+
+const (
+	// httpClientTimeout is the timeout of the http.Client used when
+	// WithHTTPClient is not given.
+	httpClientTimeout = 30 * time.Second
+
+	// httpMaxRetries is how many times a request is retried after a
+	// retryable failure.
+	httpMaxRetries = 4
+
+	httpMinBackoff = 50 * time.Millisecond
+	httpMaxBackoff = 2 * time.Second
+)
+
+// httpClient performs Client operations against a server returned by
+// NewHandler.
+type httpClient struct {
+	endpoint  string
+	client    *http.Client
+	userAgent string
+
+	// ctx is the context of every request, set by Client.WithContext.
+	ctx context.Context
+}
+
+func newHTTPClient(endpoint string, client *http.Client, userAgent string) (*httpClient, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing endpoint %q: %s", endpoint, err)
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("Error parsing endpoint %q: scheme must be http or https", endpoint)
+	}
+
+	if client == nil {
+		client = &http.Client{Timeout: httpClientTimeout}
+	}
+
+	if userAgent == "" {
+		userAgent = DefaultUserAgent
+	}
+
+	return &httpClient{
+		endpoint:  strings.TrimSuffix(u.String(), "/"),
+		client:    client,
+		userAgent: userAgent,
+	}, nil
+}
+
+func (h *httpClient) createUser(user *User) error {
+	return h.do(http.MethodPost, "/users", nil, user, user)
+}
+
+func (h *httpClient) readUser(email string) (*User, error) {
+	return readOrNil[User](h, "/users/"+url.PathEscape(email))
+}
+
+func (h *httpClient) updateUser(user *User, version *int) error {
+	var updated User
+
+	err := h.do(http.MethodPut, "/users/"+url.PathEscape(user.Email), version, user, &updated)
+	if err != nil {
+		return err
+	}
+
+	user.Version = updated.Version
+
+	return nil
+}
+
+func (h *httpClient) deleteUser(user *User, version *int) error {
+	return h.do(http.MethodDelete, "/users/"+url.PathEscape(user.Email), version, nil, nil)
+}
+
+func (h *httpClient) restoreUser(email string) (*User, error) {
+	var user User
+
+	if err := h.do(http.MethodPost, "/users/"+url.PathEscape(email)+"/restore", nil, nil, &user); err != nil {
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+func (h *httpClient) readTombstone(email string) (*Tombstone, error) {
+	return readOrNil[Tombstone](h, "/tombstones/"+url.PathEscape(email))
+}
+
+func (h *httpClient) listUsers(req ListUsersRequest) (*ListUsersPage, error) {
+	query := url.Values{}
+
+	if req.MinAge != nil {
+		query.Set("min_age", strconv.Itoa(*req.MinAge))
+	}
+	if req.MaxAge != nil {
+		query.Set("max_age", strconv.Itoa(*req.MaxAge))
+	}
+	if req.Language != "" {
+		query.Set("language", req.Language)
+	}
+	if req.EmailPrefix != "" {
+		query.Set("email_prefix", req.EmailPrefix)
+	}
+	if req.SortBy != "" {
+		query.Set("sort", string(req.SortBy))
+	}
+	if req.Descending {
+		query.Set("order", "desc")
+	}
+	if req.PageSize != 0 {
+		query.Set("page_size", strconv.Itoa(req.PageSize))
+	}
+	if req.PageToken != "" {
+		query.Set("page_token", req.PageToken)
+	}
+
+	path := "/users"
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+
+	resp, err := h.send(http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &ListUsersPage{}
+
+	if err := decodeResponse(resp, &page.Users); err != nil {
+		return nil, err
+	}
+
+	page.TotalCount, _ = strconv.Atoi(resp.Header.Get("X-Total-Count"))
+
+	if next := nextLink(resp.Header.Get("Link")); next != "" {
+		u, err := url.Parse(next)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing next page link %q: %s", next, err)
+		}
+
+		page.NextPageToken = u.Query().Get("page_token")
+	}
+
+	return page, nil
+}
+
+func (h *httpClient) readRegions() ([]*Region, error) {
+	return getPages[*Region](h, "/regions")
+}
+
+func (h *httpClient) createRegion(region *Region) error {
+	return h.do(http.MethodPost, "/regions", nil, region, nil)
+}
+
+func (h *httpClient) readRegion(name string) (*Region, error) {
+	return readOrNil[Region](h, "/regions/"+url.PathEscape(name))
+}
+
+func (h *httpClient) updateRegion(region *Region) error {
+	return h.do(http.MethodPut, "/regions/"+url.PathEscape(region.Name), nil, region, nil)
+}
+
+func (h *httpClient) deleteRegion(region *Region) error {
+	return h.do(http.MethodDelete, "/regions/"+url.PathEscape(region.Name), nil, nil, nil)
+}
+
+func (h *httpClient) createGroup(group *Group) error {
+	return h.do(http.MethodPost, "/groups", nil, group, nil)
+}
+
+func (h *httpClient) readGroup(name string) (*Group, error) {
+	return readOrNil[Group](h, "/groups/"+url.PathEscape(name))
+}
+
+func (h *httpClient) updateGroup(group *Group) error {
+	return h.do(http.MethodPut, "/groups/"+url.PathEscape(group.Name), nil, group, nil)
+}
+
+func (h *httpClient) deleteGroup(group *Group) error {
+	return h.do(http.MethodDelete, "/groups/"+url.PathEscape(group.Name), nil, nil, nil)
+}
+
+func (h *httpClient) createMembership(membership *Membership) error {
+	return h.do(http.MethodPost, "/groups/"+url.PathEscape(membership.Group)+"/members", nil, membership, nil)
+}
+
+func (h *httpClient) readMembership(group string, email string) (*Membership, error) {
+	return readOrNil[Membership](h, "/groups/"+url.PathEscape(group)+"/members/"+url.PathEscape(email))
+}
+
+func (h *httpClient) deleteMembership(membership *Membership) error {
+	return h.do(http.MethodDelete, "/groups/"+url.PathEscape(membership.Group)+"/members/"+url.PathEscape(membership.Email), nil, nil, nil)
+}
+
+func (h *httpClient) startAsyncOperation(req AsyncOperationRequest) (string, error) {
+	var op AsyncOperation
+
+	if err := h.do(http.MethodPost, "/operations", nil, req, &op); err != nil {
+		return "", err
+	}
+
+	return op.ID, nil
+}
+
+func (h *httpClient) readAsyncOperation(id string) (*AsyncOperation, error) {
+	return readOrNil[AsyncOperation](h, "/operations/"+url.PathEscape(id))
+}
+
+// readOrNil gets the record at path, returning nil if it does not exist.
+func readOrNil[T any](h *httpClient, path string) (*T, error) {
+	var record T
+
+	err := h.do(http.MethodGet, path, nil, nil, &record)
+
+	var statusErr *httpStatusError
+	if errors.As(err, &statusErr) && statusErr.code == errorCodeNotFound {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &record, nil
+}
+
+// getPages gets every item of the list at path, following the Link headers
+// of the responses until the last page.
+func getPages[T any](h *httpClient, path string) ([]T, error) {
+	items := []T{}
+
+	for path != "" {
+		var page []T
+
+		resp, err := h.send(http.MethodGet, path, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		err = decodeResponse(resp, &page)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, page...)
+
+		path, err = h.linkPath(nextLink(resp.Header.Get("Link")))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return items, nil
+}
+
+// nextLink returns the target of the rel="next" link in a Link header, or
+// an empty string if there is none.
+func nextLink(header string) string {
+	for _, link := range strings.Split(header, ",") {
+		target, params, ok := strings.Cut(strings.TrimSpace(link), ";")
+		if !ok || !strings.Contains(params, `rel="next"`) {
+			continue
+		}
+
+		return strings.Trim(strings.TrimSpace(target), "<>")
+	}
+
+	return ""
+}
+
+// linkPath returns the path, relative to the endpoint, of a link returned by
+// the server. The server links to the path the request was sent to, which
+// includes the path of the endpoint, if any.
+func (h *httpClient) linkPath(link string) (string, error) {
+	if link == "" {
+		return "", nil
+	}
+
+	ref, err := url.Parse(link)
+	if err != nil {
+		return "", fmt.Errorf("Error parsing link %q: %s", link, err)
+	}
+
+	base, err := url.Parse(h.endpoint + "/")
+	if err != nil {
+		return "", fmt.Errorf("Error parsing endpoint %q: %s", h.endpoint, err)
+	}
+
+	target := base.ResolveReference(ref).String()
+
+	path, ok := strings.CutPrefix(target, h.endpoint)
+	if !ok || !strings.HasPrefix(path, "/") {
+		return "", fmt.Errorf("Error following link %q: not under the endpoint %q", link, h.endpoint)
+	}
+
+	return path, nil
+}
+
+// httpStatusError is returned for unsuccessful responses that do not map to
+// one of the package's error types.
+type httpStatusError struct {
+	code    string
+	message string
+}
+
+func (e *httpStatusError) Error() string {
+	return e.message
+}
+
+// do sends a request with in as its JSON body, conditional on version if it
+// is not nil, and decodes the JSON response body into out if it is not nil.
+func (h *httpClient) do(method string, path string, version *int, in any, out any) error {
+	var header http.Header
+	if version != nil {
+		header = http.Header{"If-Match": []string{strconv.Itoa(*version)}}
+	}
+
+	resp, err := h.send(method, path, header, in)
+	if err != nil {
+		return err
+	}
+
+	return decodeResponse(resp, out)
+}
+
+// send sends a request, retrying with exponential backoff while the server
+// is rate limiting, which rejects requests before handling them. Reads are
+// also retried while the server is unavailable or unreachable, but writes
+// are not, as the server may already have committed them.
+func (h *httpClient) send(method string, path string, header http.Header, in any) (*http.Response, error) {
+	var body []byte
+	if in != nil {
+		var err error
+		body, err = json.Marshal(in)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ctx := h.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	backoff := httpMinBackoff
+
+	for attempt := 0; ; attempt++ {
+		req, err := http.NewRequestWithContext(ctx, method, h.endpoint+path, bytes.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+
+		for k, v := range header {
+			req.Header[k] = v
+		}
+
+		req.Header.Set("Accept", "application/json")
+		req.Header.Set("User-Agent", h.userAgent)
+		if in != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+
+		resp, err := h.client.Do(req)
+
+		retry := false
+		wait := backoff
+
+		switch {
+		case err != nil:
+			retry = method == http.MethodGet
+			err = fmt.Errorf("Error sending %s %s: %w", method, path, err)
+		case retryableStatus(method, resp.StatusCode):
+			retry = true
+			if after := retryAfter(resp.Header.Get("Retry-After")); after > 0 {
+				wait = after
+			}
+		}
+
+		if !retry || attempt == httpMaxRetries {
+			return resp, err
+		}
+
+		if resp != nil {
+			// drain the body so the connection can be reused
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("Error sending %s %s: %w", method, path, ctx.Err())
+		case <-time.After(wait):
+		}
+
+		backoff = min(backoff*2, httpMaxBackoff)
+	}
+}
+
+// retryableStatus returns whether a request with the given method can be
+// retried after a response with the given status.
+func retryableStatus(method string, status int) bool {
+	switch status {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return method == http.MethodGet
+	}
+
+	return false
+}
+
+// retryAfter returns the delay in a Retry-After header given in seconds, or
+// zero if there is none.
+func retryAfter(header string) time.Duration {
+	seconds, err := strconv.Atoi(header)
+	if err != nil || seconds < 0 {
+		return 0
+	}
+
+	return time.Duration(seconds) * time.Second
+}
+
+// decodeResponse closes the response body after decoding it into out, or
+// into the corresponding error if the response is unsuccessful.
+func decodeResponse(resp *http.Response, out any) error {
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		if out == nil || resp.StatusCode == http.StatusNoContent {
+			return nil
+		}
+
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			return fmt.Errorf("Error decoding response from %s %s: %s", resp.Request.Method, resp.Request.URL.Path, err)
+		}
+
+		return nil
+	}
+
+	var body httpError
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil || body.Message == "" {
+		return &httpStatusError{
+			message: fmt.Sprintf("Unexpected response from %s %s: %s", resp.Request.Method, resp.Request.URL.Path, resp.Status),
+		}
+	}
+
+	switch {
+	case body.Code == errorCodeConflict && body.Conflict != nil:
+		return body.Conflict
+	case body.Code == errorCodeInUse && body.InUse != nil:
+		return body.InUse
+	case body.Code == errorCodeTombstoned && body.Tombstone != nil:
+		return body.Tombstone
+	case body.Code == errorCodeThrottled && body.Throttling != nil:
+		return body.Throttling
+	case body.Code == errorCodeQuota && body.Quota != nil:
+		return body.Quota
+	}
+
+	return &httpStatusError{
+		code:    body.Code,
+		message: body.Message,
+	}
+}
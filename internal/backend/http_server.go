@@ -0,0 +1,671 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package backend
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const (
+	// defaultPageSize is the number of items returned by list endpoints when
+	// the request does not give a page_size.
+	defaultPageSize = 100
+
+	// MaxPageSize is the largest page_size accepted by list endpoints, and the
+	// largest PageSize of a ListUsersRequest.
+	MaxPageSize = 1000
+)
+
+// Error codes returned in the body of unsuccessful responses.
+const (
+	errorCodeBadRequest  = "bad_request"
+	errorCodeNotFound    = "not_found"
+	errorCodeConflict    = "conflict"
+	errorCodeInUse       = "in_use"
+	errorCodeTombstoned  = "tombstoned"
+	errorCodeThrottled   = "throttled"
+	errorCodeQuota       = "quota_exceeded"
+	errorCodeUnavailable = "unavailable"
+)
+
+// httpError is the body of unsuccessful responses.
+type httpError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+
+	// Conflict is set when Code is errorCodeConflict.
+	Conflict *ConflictError `json:"conflict,omitempty"`
+
+	// InUse is set when Code is errorCodeInUse.
+	InUse *InUseError `json:"in_use,omitempty"`
+
+	// Tombstone is set when Code is errorCodeTombstoned.
+	Tombstone *TombstoneError `json:"tombstone,omitempty"`
+
+	// Throttling is set when Code is errorCodeThrottled.
+	Throttling *ThrottlingError `json:"throttling,omitempty"`
+
+	// Quota is set when Code is errorCodeQuota.
+	Quota *QuotaError `json:"quota,omitempty"`
+}
+
+// NewHandler returns an http.Handler serving a REST API over the client,
+// which can be hosted with net/http/httptest and used by clients created
+// with WithEndpoint. The API is:
+//
+//	GET    /users                          list users
+//	POST   /users                          create a user
+//	GET    /users/{email}                  read a user
+//	PUT    /users/{email}                  update a user
+//	DELETE /users/{email}                  delete a user
+//	POST   /users/{email}/restore          restore a deleted user
+//	GET    /tombstones/{email}             read the tombstone of a deleted user
+//	GET    /regions                        list regions
+//	POST   /regions                        create a region
+//	GET    /regions/{name}                 read a region
+//	PUT    /regions/{name}                 update a region
+//	DELETE /regions/{name}                 delete a region
+//	POST   /groups                         create a group
+//	GET    /groups/{name}                  read a group
+//	PUT    /groups/{name}                  update a group
+//	DELETE /groups/{name}                  delete a group
+//	POST   /groups/{name}/members          create a membership
+//	GET    /groups/{name}/members/{email}  read a membership
+//	DELETE /groups/{name}/members/{email}  delete a membership
+//	POST   /operations                     start an async operation
+//	GET    /operations/{id}                read an async operation
+//
+// Updates and deletes of users are conditional on the version given in the
+// If-Match header, when present. List endpoints are paginated with the
+// page_size and page_token query parameters; the next page is linked by the
+// Link header, and the X-Total-Count header holds the total number of items.
+// Users are filtered by the min_age, max_age, language and email_prefix
+// query parameters, and sorted by the sort and order query parameters.
+func NewHandler(c *Client) http.Handler {
+	s := &httpServer{client: c}
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /users", s.listUsers)
+	mux.HandleFunc("POST /users", s.createUser)
+	mux.HandleFunc("GET /users/{email}", s.readUser)
+	mux.HandleFunc("PUT /users/{email}", s.updateUser)
+	mux.HandleFunc("DELETE /users/{email}", s.deleteUser)
+	mux.HandleFunc("POST /users/{email}/restore", s.restoreUser)
+	mux.HandleFunc("GET /tombstones/{email}", s.readTombstone)
+	mux.HandleFunc("GET /regions", s.readRegions)
+	mux.HandleFunc("POST /regions", s.createRegion)
+	mux.HandleFunc("GET /regions/{name}", s.readRegion)
+	mux.HandleFunc("PUT /regions/{name}", s.updateRegion)
+	mux.HandleFunc("DELETE /regions/{name}", s.deleteRegion)
+	mux.HandleFunc("POST /groups", s.createGroup)
+	mux.HandleFunc("GET /groups/{name}", s.readGroup)
+	mux.HandleFunc("PUT /groups/{name}", s.updateGroup)
+	mux.HandleFunc("DELETE /groups/{name}", s.deleteGroup)
+	mux.HandleFunc("POST /groups/{name}/members", s.createMembership)
+	mux.HandleFunc("GET /groups/{name}/members/{email}", s.readMembership)
+	mux.HandleFunc("DELETE /groups/{name}/members/{email}", s.deleteMembership)
+	mux.HandleFunc("POST /operations", s.startAsyncOperation)
+	mux.HandleFunc("GET /operations/{id}", s.readAsyncOperation)
+
+	return mux
+}
+
+type httpServer struct {
+	client *Client
+}
+
+func (s *httpServer) listUsers(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	req := ListUsersRequest{
+		Language:    query.Get("language"),
+		EmailPrefix: query.Get("email_prefix"),
+		SortBy:      UserSortField(query.Get("sort")),
+		Descending:  query.Get("order") == "desc",
+		PageToken:   query.Get("page_token"),
+	}
+
+	var pageSize *int
+	var err error
+
+	for name, field := range map[string]**int{
+		"min_age":   &req.MinAge,
+		"max_age":   &req.MaxAge,
+		"page_size": &pageSize,
+	} {
+		if *field, err = queryInt(query, name); err != nil {
+			writeJSON(w, http.StatusBadRequest, &httpError{
+				Code:    errorCodeBadRequest,
+				Message: err.Error(),
+			})
+			return
+		}
+	}
+
+	if pageSize != nil {
+		req.PageSize = *pageSize
+	}
+
+	page, err := s.client.ListUsers(req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	if page.NextPageToken != "" {
+		query.Set("page_token", page.NextPageToken)
+
+		w.Header().Set("Link", fmt.Sprintf(`<%s?%s>; rel="next"`, requestPath(r), query.Encode()))
+	}
+
+	w.Header().Set("X-Total-Count", strconv.Itoa(page.TotalCount))
+
+	writeJSON(w, http.StatusOK, page.Users)
+}
+
+func (s *httpServer) createUser(w http.ResponseWriter, r *http.Request) {
+	var user User
+	if !decodeBody(w, r, &user) {
+		return
+	}
+
+	if err := s.client.CreateUser(&user); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, &user)
+}
+
+func (s *httpServer) readUser(w http.ResponseWriter, r *http.Request) {
+	email := r.PathValue("email")
+
+	user, err := s.client.ReadUser(email)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	if user == nil {
+		writeNotFound(w, fmt.Sprintf("User with email %s not in db", email))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, user)
+}
+
+func (s *httpServer) updateUser(w http.ResponseWriter, r *http.Request) {
+	var user User
+	if !decodeBody(w, r, &user) {
+		return
+	}
+
+	user.Email = r.PathValue("email")
+
+	version, ok := ifMatch(w, r)
+	if !ok {
+		return
+	}
+
+	var err error
+	if version != nil {
+		err = s.client.UpdateUserIfVersion(&user, *version)
+	} else {
+		err = s.client.UpdateUser(&user)
+	}
+
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, &user)
+}
+
+func (s *httpServer) deleteUser(w http.ResponseWriter, r *http.Request) {
+	user := &User{Email: r.PathValue("email")}
+
+	version, ok := ifMatch(w, r)
+	if !ok {
+		return
+	}
+
+	var err error
+	if version != nil {
+		err = s.client.DeleteUserIfVersion(user, *version)
+	} else {
+		err = s.client.DeleteUser(user)
+	}
+
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *httpServer) restoreUser(w http.ResponseWriter, r *http.Request) {
+	user, err := s.client.RestoreUser(r.PathValue("email"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, user)
+}
+
+func (s *httpServer) readTombstone(w http.ResponseWriter, r *http.Request) {
+	email := r.PathValue("email")
+
+	tombstone, err := s.client.ReadTombstone(email)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	if tombstone == nil {
+		writeNotFound(w, fmt.Sprintf("Deleted user with email %s not in db", email))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, tombstone)
+}
+
+func (s *httpServer) readRegions(w http.ResponseWriter, r *http.Request) {
+	regions, err := s.client.ReadRegions()
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writePage(w, r, regions)
+}
+
+func (s *httpServer) createRegion(w http.ResponseWriter, r *http.Request) {
+	var region Region
+	if !decodeBody(w, r, &region) {
+		return
+	}
+
+	if err := s.client.CreateRegion(&region); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, &region)
+}
+
+func (s *httpServer) readRegion(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+
+	region, err := s.client.ReadRegion(name)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	if region == nil {
+		writeNotFound(w, fmt.Sprintf("Region %s not in db", name))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, region)
+}
+
+func (s *httpServer) updateRegion(w http.ResponseWriter, r *http.Request) {
+	var region Region
+	if !decodeBody(w, r, &region) {
+		return
+	}
+
+	region.Name = r.PathValue("name")
+
+	if err := s.client.UpdateRegion(&region); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, &region)
+}
+
+func (s *httpServer) deleteRegion(w http.ResponseWriter, r *http.Request) {
+	if err := s.client.DeleteRegion(&Region{Name: r.PathValue("name")}); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *httpServer) createGroup(w http.ResponseWriter, r *http.Request) {
+	var group Group
+	if !decodeBody(w, r, &group) {
+		return
+	}
+
+	if err := s.client.CreateGroup(&group); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, &group)
+}
+
+func (s *httpServer) readGroup(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+
+	group, err := s.client.ReadGroup(name)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	if group == nil {
+		writeNotFound(w, fmt.Sprintf("Group %s not in db", name))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, group)
+}
+
+func (s *httpServer) updateGroup(w http.ResponseWriter, r *http.Request) {
+	var group Group
+	if !decodeBody(w, r, &group) {
+		return
+	}
+
+	group.Name = r.PathValue("name")
+
+	if err := s.client.UpdateGroup(&group); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, &group)
+}
+
+func (s *httpServer) deleteGroup(w http.ResponseWriter, r *http.Request) {
+	if err := s.client.DeleteGroup(&Group{Name: r.PathValue("name")}); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *httpServer) createMembership(w http.ResponseWriter, r *http.Request) {
+	var membership Membership
+	if !decodeBody(w, r, &membership) {
+		return
+	}
+
+	membership.Group = r.PathValue("name")
+
+	if err := s.client.CreateMembership(&membership); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, &membership)
+}
+
+func (s *httpServer) readMembership(w http.ResponseWriter, r *http.Request) {
+	group, email := r.PathValue("name"), r.PathValue("email")
+
+	membership, err := s.client.ReadMembership(group, email)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	if membership == nil {
+		writeNotFound(w, fmt.Sprintf("User with email %s is not a member of group %s", email, group))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, membership)
+}
+
+func (s *httpServer) deleteMembership(w http.ResponseWriter, r *http.Request) {
+	membership := &Membership{
+		Group: r.PathValue("name"),
+		Email: r.PathValue("email"),
+	}
+
+	if err := s.client.DeleteMembership(membership); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *httpServer) startAsyncOperation(w http.ResponseWriter, r *http.Request) {
+	var req AsyncOperationRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+
+	id, err := s.client.StartAsyncOperation(req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	op, err := s.client.ReadAsyncOperation(id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusAccepted, op)
+}
+
+func (s *httpServer) readAsyncOperation(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+
+	op, err := s.client.ReadAsyncOperation(id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	if op == nil {
+		writeNotFound(w, fmt.Sprintf("Operation %s not in db", id))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, op)
+}
+
+// decodeBody decodes the JSON request body into v, writing a 400 response
+// and returning false if it is invalid.
+func decodeBody(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeJSON(w, http.StatusBadRequest, &httpError{
+			Code:    errorCodeBadRequest,
+			Message: fmt.Sprintf("Error decoding request body: %s", err),
+		})
+
+		return false
+	}
+
+	return true
+}
+
+// ifMatch returns the version in the If-Match header, or nil if there is
+// none, writing a 400 response and returning false if it is invalid.
+func ifMatch(w http.ResponseWriter, r *http.Request) (*int, bool) {
+	header := r.Header.Get("If-Match")
+	if header == "" {
+		return nil, true
+	}
+
+	version, err := strconv.Atoi(header)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, &httpError{
+			Code:    errorCodeBadRequest,
+			Message: fmt.Sprintf("Invalid If-Match header %q: expected a version number", header),
+		})
+
+		return nil, false
+	}
+
+	return &version, true
+}
+
+// queryInt returns the integer query parameter, or nil if it is not given.
+func queryInt(query url.Values, name string) (*int, error) {
+	v := query.Get(name)
+	if v == "" {
+		return nil, nil
+	}
+
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid %s %q: expected a number", name, v)
+	}
+
+	return &n, nil
+}
+
+// requestPath returns the path of the request as the client sent it, so that
+// links stay valid when the handler is mounted under a prefix, for example
+// with http.StripPrefix.
+func requestPath(r *http.Request) string {
+	if u, err := url.ParseRequestURI(r.RequestURI); err == nil && u.Path != "" {
+		return u.EscapedPath()
+	}
+
+	return r.URL.EscapedPath()
+}
+
+// writePage writes the page of items selected by the page_size and
+// page_token query parameters, linking to the next page if there is one.
+// The page token is the offset of the first item of the page.
+func writePage[T any](w http.ResponseWriter, r *http.Request, items []T) {
+	query := r.URL.Query()
+
+	size := defaultPageSize
+	if v := query.Get("page_size"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > MaxPageSize {
+			writeJSON(w, http.StatusBadRequest, &httpError{
+				Code:    errorCodeBadRequest,
+				Message: fmt.Sprintf("Invalid page_size %q: expected a number from 1 to %d", v, MaxPageSize),
+			})
+			return
+		}
+
+		size = n
+	}
+
+	offset := 0
+	if v := query.Get("page_token"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 || n > len(items) {
+			writeJSON(w, http.StatusBadRequest, &httpError{
+				Code:    errorCodeBadRequest,
+				Message: fmt.Sprintf("Invalid page_token %q", v),
+			})
+			return
+		}
+
+		offset = n
+	}
+
+	end := min(offset+size, len(items))
+
+	if end < len(items) {
+		next := url.Values{}
+		next.Set("page_size", strconv.Itoa(size))
+		next.Set("page_token", strconv.Itoa(end))
+
+		w.Header().Set("Link", fmt.Sprintf(`<%s?%s>; rel="next"`, requestPath(r), next.Encode()))
+	}
+
+	w.Header().Set("X-Total-Count", strconv.Itoa(len(items)))
+
+	writeJSON(w, http.StatusOK, items[offset:end])
+}
+
+func writeNotFound(w http.ResponseWriter, message string) {
+	writeJSON(w, http.StatusNotFound, &httpError{
+		Code:    errorCodeNotFound,
+		Message: message,
+	})
+}
+
+// writeError writes the response for an error returned by the Client.
+// Injected faults are reported as the service being unavailable, and
+// throttling as too many requests, so that they exercise the retries of the
+// HTTP client.
+func writeError(w http.ResponseWriter, err error) {
+	body := &httpError{
+		Code:    errorCodeBadRequest,
+		Message: err.Error(),
+	}
+	status := http.StatusBadRequest
+
+	var conflictErr *ConflictError
+	var inUseErr *InUseError
+	var tombstoneErr *TombstoneError
+	var throttlingErr *ThrottlingError
+	var quotaErr *QuotaError
+	var faultErr *InjectedFaultError
+	var retryAfter time.Duration
+
+	switch {
+	case errors.As(err, &conflictErr):
+		body.Code = errorCodeConflict
+		body.Conflict = conflictErr
+		status = http.StatusConflict
+	case errors.As(err, &inUseErr):
+		body.Code = errorCodeInUse
+		body.InUse = inUseErr
+		status = http.StatusConflict
+	case errors.As(err, &tombstoneErr):
+		body.Code = errorCodeTombstoned
+		body.Tombstone = tombstoneErr
+		status = http.StatusConflict
+	case errors.As(err, &throttlingErr):
+		body.Code = errorCodeThrottled
+		body.Throttling = throttlingErr
+		status = http.StatusTooManyRequests
+		retryAfter = throttlingErr.RetryAfter
+	case errors.As(err, &quotaErr):
+		body.Code = errorCodeQuota
+		body.Quota = quotaErr
+		status = http.StatusForbidden
+		retryAfter = quotaErr.RetryAfter
+	case errors.As(err, &faultErr):
+		body.Code = errorCodeUnavailable
+		status = http.StatusServiceUnavailable
+	}
+
+	if retryAfter > 0 {
+		// Retry-After is given in whole seconds, so round up
+		w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+	}
+
+	writeJSON(w, status, body)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	// The status has been written, so there is nothing left to do if the
+	// client has gone away.
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,517 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package backend
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// newTestServer returns a server with its own database, and a client of it.
+func newTestServer(t *testing.T, server *Client, opts ...Option) *Client {
+	t.Helper()
+
+	if server == nil {
+		var err error
+		server, err = NewClient(WithFreshDatabase())
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	ts := httptest.NewServer(NewHandler(server))
+	t.Cleanup(ts.Close)
+
+	c, err := NewClient(append([]Option{WithEndpoint(ts.URL)}, opts...)...)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return c
+}
+
+func TestHTTPClient_users(t *testing.T) {
+	t.Parallel()
+
+	c := newTestServer(t, nil)
+
+	user, err := c.ReadUser("ford@prefect.co")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if user != nil {
+		t.Fatalf("expected no user, got: %+v", user)
+	}
+
+	user = &User{Email: "ford@prefect.co", Name: "Ford Prefect", Age: 200}
+	if err := c.CreateUser(user); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if user.Version != 1 || user.Language != "en" || user.DateJoined == "" {
+		t.Errorf("expected created user to be populated by the server, got: %+v", user)
+	}
+
+	if err := c.CreateUser(&User{Email: "ford@prefect.co"}); err == nil || !strings.Contains(err.Error(), "user already exists") {
+		t.Errorf("expected error creating duplicate user, got: %v", err)
+	}
+
+	user.Name = "Ix"
+	user.Tags = map[string]string{"planet": "betelgeuse"}
+	if err := c.UpdateUserIfVersion(user, 1); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if user.Version != 2 {
+		t.Errorf("expected version 2, got: %d", user.Version)
+	}
+
+	var conflictErr *ConflictError
+
+	if err := c.UpdateUserIfVersion(user, 1); !errors.As(err, &conflictErr) {
+		t.Fatalf("expected ConflictError, got: %v", err)
+	}
+
+	if conflictErr.ExpectedVersion != 1 || conflictErr.ActualVersion != 2 {
+		t.Errorf("unexpected conflict: %+v", conflictErr)
+	}
+
+	got, err := c.ReadUser("ford@prefect.co")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got == nil || got.Name != "Ix" || got.Version != 2 || got.Tags["planet"] != "betelgeuse" {
+		t.Errorf("unexpected user: %+v", got)
+	}
+
+	if err := c.DeleteUserIfVersion(user, 1); !errors.As(err, &conflictErr) {
+		t.Errorf("expected ConflictError, got: %v", err)
+	}
+
+	if err := c.DeleteUserIfVersion(user, 2); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := c.DeleteUser(user); err == nil || !strings.Contains(err.Error(), "email not in db") {
+		t.Errorf("expected error deleting missing user, got: %v", err)
+	}
+}
+
+func TestHTTPClient_groups(t *testing.T) {
+	t.Parallel()
+
+	c := newTestServer(t, nil)
+
+	if err := c.CreateGroup(&Group{Name: "hitchhikers", Description: "Hitchhikers"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := c.UpdateGroup(&Group{Name: "hitchhikers", Description: "Guide"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	group, err := c.ReadGroup("hitchhikers")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if group == nil || group.Description != "Guide" {
+		t.Errorf("unexpected group: %+v", group)
+	}
+
+	user := &User{Email: "ford@prefect.co", Name: "Ford Prefect", Age: 200}
+	if err := c.CreateUser(user); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	membership := &Membership{Group: "hitchhikers", Email: "ford@prefect.co"}
+	if err := c.CreateMembership(membership); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got, err := c.ReadMembership("hitchhikers", "ford@prefect.co")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got == nil || *got != *membership {
+		t.Errorf("unexpected membership: %+v", got)
+	}
+
+	var inUseErr *InUseError
+
+	if err := c.DeleteGroup(&Group{Name: "hitchhikers"}); !errors.As(err, &inUseErr) {
+		t.Errorf("expected InUseError deleting group with members, got: %v", err)
+	}
+
+	if err := c.DeleteMembership(membership); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got, err = c.ReadMembership("hitchhikers", "ford@prefect.co")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got != nil {
+		t.Errorf("expected no membership, got: %+v", got)
+	}
+
+	if err := c.DeleteGroup(&Group{Name: "hitchhikers"}); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
+
+func TestHTTPClient_ReadRegions(t *testing.T) {
+	t.Parallel()
+
+	server, err := NewClient(WithFreshDatabase())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var mu sync.Mutex
+	var requests []string
+
+	// force a page per region, so the client has to follow the links
+	handler := NewHandler(server)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests = append(requests, r.URL.RequestURI())
+		mu.Unlock()
+
+		if r.URL.Query().Get("page_size") == "" {
+			r.URL.RawQuery = "page_size=1"
+		}
+
+		handler.ServeHTTP(w, r)
+	}))
+	t.Cleanup(ts.Close)
+
+	c, err := NewClient(WithEndpoint(ts.URL))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	regions, err := c.ReadRegions()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(regions) != 3 {
+		t.Errorf("expected 3 regions, got: %d", len(regions))
+	}
+
+	expected := []string{
+		"/regions",
+		"/regions?page_size=1&page_token=1",
+		"/regions?page_size=1&page_token=2",
+	}
+
+	if strings.Join(requests, " ") != strings.Join(expected, " ") {
+		t.Errorf("expected requests %q, got: %q", expected, requests)
+	}
+}
+
+func TestHandler_pagination(t *testing.T) {
+	t.Parallel()
+
+	server, err := NewClient(WithFreshDatabase())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ts := httptest.NewServer(NewHandler(server))
+	t.Cleanup(ts.Close)
+
+	resp, err := http.Get(ts.URL + "/regions?page_size=2")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+
+	if got := resp.Header.Get("X-Total-Count"); got != "3" {
+		t.Errorf("expected X-Total-Count 3, got: %q", got)
+	}
+
+	if got := nextLink(resp.Header.Get("Link")); got != "/regions?page_size=2&page_token=2" {
+		t.Errorf("unexpected next link: %q", got)
+	}
+
+	resp, err = http.Get(ts.URL + "/regions?page_size=0")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("expected status 400 for invalid page size, got: %d", resp.StatusCode)
+	}
+}
+
+func TestHandler_paginationPrefix(t *testing.T) {
+	t.Parallel()
+
+	server, err := NewClient(WithFreshDatabase())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/api/", http.StripPrefix("/api", NewHandler(server)))
+
+	ts := httptest.NewServer(mux)
+	t.Cleanup(ts.Close)
+
+	resp, err := http.Get(ts.URL + "/api/regions?page_size=2")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+
+	link := nextLink(resp.Header.Get("Link"))
+	if link != "/api/regions?page_size=2&page_token=2" {
+		t.Errorf("unexpected next link: %q", link)
+	}
+
+	h, err := newHTTPClient(ts.URL+"/api", http.DefaultClient, "test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	path, err := h.linkPath(link)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if path != "/regions?page_size=2&page_token=2" {
+		t.Errorf("unexpected link path: %q", path)
+	}
+
+	regions, err := getPages[*Region](h, "/regions?page_size=2")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(regions) != 3 {
+		t.Errorf("expected 3 regions, got: %d", len(regions))
+	}
+
+	if _, err := h.linkPath("http://example.com/regions"); err == nil {
+		t.Error("expected error for link outside of the endpoint")
+	}
+}
+
+func TestHTTPClient_retry(t *testing.T) {
+	t.Parallel()
+
+	faults := NewFaultInjector(FaultRule{
+		Operation: OperationReadRegions,
+		Error:     "connection reset",
+		Call:      1,
+	})
+
+	server, err := NewClient(WithFreshDatabase(), WithFaultInjector(faults))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	c := newTestServer(t, server)
+
+	if _, err := c.ReadRegions(); err != nil {
+		t.Fatalf("expected the unavailable response to be retried, got: %s", err)
+	}
+
+	if got := faults.Calls(OperationReadRegions); got != 2 {
+		t.Errorf("expected 2 calls, got: %d", got)
+	}
+
+	faults.AddRule(FaultRule{
+		Operation: OperationReadRegions,
+		Error:     "connection reset",
+	})
+
+	if _, err := c.ReadRegions(); err == nil || !strings.Contains(err.Error(), "connection reset") {
+		t.Errorf("expected error once retries are exhausted, got: %v", err)
+	}
+
+	if got := faults.Calls(OperationReadRegions); got != 2+httpMaxRetries+1 {
+		t.Errorf("expected %d calls, got: %d", 2+httpMaxRetries+1, got)
+	}
+}
+
+// A write that failed after it was committed must not be re-sent, or the
+// retry would fail on the committed write instead.
+func TestHTTPClient_retryWriteAfterCommit(t *testing.T) {
+	t.Parallel()
+
+	faults := NewFaultInjector(FaultRule{
+		Operation:   OperationCreateUser,
+		Error:       "connection reset",
+		Call:        1,
+		AfterCommit: true,
+	})
+
+	server, err := NewClient(WithFreshDatabase(), WithFaultInjector(faults))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	c := newTestServer(t, server)
+
+	err = c.CreateUser(&User{Email: "ford@prefect.co", Name: "Ford Prefect", Age: 200})
+	if err == nil || !strings.Contains(err.Error(), "connection reset") {
+		t.Errorf("expected the injected fault, got: %v", err)
+	}
+
+	if got := faults.Calls(OperationCreateUser); got != 1 {
+		t.Errorf("expected 1 call, got: %d", got)
+	}
+
+	if got, err := server.ReadUser("ford@prefect.co"); err != nil || got == nil {
+		t.Errorf("expected the user to be committed, got: %v, %v", got, err)
+	}
+}
+
+func TestHTTPClient_context(t *testing.T) {
+	t.Parallel()
+
+	faults := NewFaultInjector(FaultRule{
+		Operation: OperationReadRegions,
+		Error:     "connection reset",
+	})
+
+	server, err := NewClient(WithFreshDatabase(), WithFaultInjector(faults))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	c := newTestServer(t, server)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = c.WithContext(ctx).ReadRegions()
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context canceled error, got: %v", err)
+	}
+
+	if got := faults.Calls(OperationReadRegions); got != 0 {
+		t.Errorf("expected no calls, got: %d", got)
+	}
+}
+
+func TestHTTPClient_userAgent(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	var userAgents []string
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		userAgents = append(userAgents, r.UserAgent())
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	t.Cleanup(ts.Close)
+
+	for _, userAgent := range []string{"", "corner-test/1.0"} {
+		c, err := NewClient(WithEndpoint(ts.URL), WithUserAgent(userAgent))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if _, err := c.ReadUser("ford@prefect.co"); err == nil {
+			t.Fatal("expected error for response without a body, got none")
+		}
+	}
+
+	expected := []string{DefaultUserAgent, "corner-test/1.0"}
+
+	if strings.Join(userAgents, " ") != strings.Join(expected, " ") {
+		t.Errorf("expected user agents %q, got: %q", expected, userAgents)
+	}
+}
+
+func TestHTTPClient_timeout(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+	}))
+	t.Cleanup(ts.Close)
+
+	c, err := NewClient(WithEndpoint(ts.URL), WithHTTPClient(&http.Client{Timeout: 10 * time.Millisecond}))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	err = c.CreateUser(&User{Email: "ford@prefect.co"})
+	if err == nil || !strings.Contains(err.Error(), "Client.Timeout exceeded") {
+		t.Errorf("expected timeout error, got: %v", err)
+	}
+}
+
+func TestNewClient_invalidEndpoint(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NewClient(WithEndpoint("localhost:8080")); err == nil {
+		t.Error("expected error for endpoint without scheme, got none")
+	}
+}
+
+func TestHTTPClient_ListUsers(t *testing.T) {
+	t.Parallel()
+
+	server := newListTestClient(t)
+	c := newTestServer(t, server)
+
+	req := ListUsersRequest{
+		MinAge:   intPtr(30),
+		Language: "es",
+		SortBy:   UserSortName,
+		PageSize: 1,
+	}
+
+	var got []string
+
+	for {
+		page, err := c.ListUsers(req)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if page.TotalCount != 2 {
+			t.Errorf("expected total count 2, got: %d", page.TotalCount)
+		}
+
+		got = append(got, emails(page.Users)...)
+
+		if page.NextPageToken == "" {
+			break
+		}
+
+		req.PageToken = page.NextPageToken
+	}
+
+	expected := []string{"fenchurch@earth.co", "trillian@astra.co"}
+
+	if !slices.Equal(got, expected) {
+		t.Errorf("expected %q, got: %q", expected, got)
+	}
+
+	if _, err := c.ListUsers(ListUsersRequest{SortBy: "date_joined"}); err == nil || !strings.Contains(err.Error(), "Invalid sort field") {
+		t.Errorf("expected error for unknown sort field, got: %v", err)
+	}
+}
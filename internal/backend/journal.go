@@ -0,0 +1,198 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package backend
+
+import (
+	"reflect"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// DefaultJournalSize is the number of entries a Journal keeps. Once it is
+// full, every new entry replaces the oldest.
+const DefaultJournalSize = 1000
+
+// JournalEntry records a single successful Client operation.
+type JournalEntry struct {
+	Time      time.Time
+	Operation Operation
+
+	// Caller is the function outside of this package which called the
+	// Client, qualified by its package directory and receiver type, such as
+	// "framework5provider.resourceUser.Create". It is only recorded once
+	// Journal.RecordCallers is enabled.
+	Caller string
+
+	// Key identifies the record, such as a user's email or a group's name.
+	// Memberships are keyed by group/email.
+	Key string
+
+	// Before and After are copies of the record before and after the
+	// operation, and are nil when the record did not exist. Reads only set
+	// After.
+	Before any
+	After  any
+}
+
+// JournalQuery filters journal entries. Zero fields match every entry.
+type JournalQuery struct {
+	Operation Operation
+	Key       string
+
+	// Caller matches entries whose Caller contains it.
+	Caller string
+
+	// Since matches entries recorded at or after it.
+	Since time.Time
+}
+
+func (q JournalQuery) matches(e JournalEntry) bool {
+	if q.Operation != "" && q.Operation != e.Operation {
+		return false
+	}
+
+	if q.Key != "" && q.Key != e.Key {
+		return false
+	}
+
+	if q.Caller != "" && !strings.Contains(e.Caller, q.Caller) {
+		return false
+	}
+
+	if !q.Since.IsZero() && e.Time.Before(q.Since) {
+		return false
+	}
+
+	return true
+}
+
+// A Journal is an append-only audit log of the last DefaultJournalSize
+// operations made by the clients of a namespace. It is safe for concurrent
+// use.
+type Journal struct {
+	mu sync.Mutex
+
+	// entries is a ring buffer, whose oldest entry is at start once it is
+	// full.
+	entries []JournalEntry
+	start   int
+
+	recordCallers atomic.Bool
+}
+
+// RecordCallers sets whether later entries record their Caller, which walks
+// the stack of every operation.
+func (j *Journal) RecordCallers(enabled bool) {
+	j.recordCallers.Store(enabled)
+}
+
+// Entries returns every entry, oldest first.
+func (j *Journal) Entries() []JournalEntry {
+	return j.Query(JournalQuery{})
+}
+
+// Query returns the entries matching q, oldest first.
+func (j *Journal) Query(q JournalQuery) []JournalEntry {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	var entries []JournalEntry
+
+	for i := range j.entries {
+		e := j.entries[(j.start+i)%len(j.entries)]
+
+		if q.matches(e) {
+			entries = append(entries, e)
+		}
+	}
+
+	return entries
+}
+
+func (j *Journal) record(op Operation, key string, before any, after any) {
+	entry := JournalEntry{
+		Time:      time.Now(),
+		Operation: op,
+		Key:       key,
+		Before:    before,
+		After:     after,
+	}
+
+	if j.recordCallers.Load() {
+		entry.Caller = caller()
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if len(j.entries) < DefaultJournalSize {
+		j.entries = append(j.entries, entry)
+
+		return
+	}
+
+	j.entries[j.start] = entry
+	j.start = (j.start + 1) % len(j.entries)
+}
+
+var journals = map[string]*Journal{}
+
+// NamespaceJournal returns the Journal shared by the clients of the
+// namespace.
+func NamespaceJournal(namespace string) *Journal {
+	namespacesMu.Lock()
+	defer namespacesMu.Unlock()
+
+	j, ok := journals[namespace]
+	if !ok {
+		j = &Journal{}
+		journals[namespace] = j
+	}
+
+	return j
+}
+
+// Journal returns the Journal the client records its operations in.
+func (c *Client) Journal() *Journal {
+	return c.journal
+}
+
+var backendPkgPath = reflect.TypeOf(Client{}).PkgPath()
+
+// caller returns the name of the first function on the stack outside of this
+// package, trimmed to its package directory.
+func caller() string {
+	pcs := make([]uintptr, 32)
+	n := runtime.Callers(3, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+
+	for {
+		frame, more := frames.Next()
+
+		if !strings.HasPrefix(frame.Function, backendPkgPath+".") {
+			name := frame.Function[strings.LastIndex(frame.Function, "/")+1:]
+
+			return strings.NewReplacer("(*", "", ")", "").Replace(name)
+		}
+
+		if !more {
+			return ""
+		}
+	}
+}
+
+// clone returns a copy of the record, so journal entries are not affected by
+// later modification.
+func clone[T any](p *T) any {
+	if p == nil {
+		return nil
+	}
+
+	c := *p
+
+	return &c
+}
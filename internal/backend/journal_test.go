@@ -0,0 +1,143 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package backend
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestJournal(t *testing.T) {
+	t.Parallel()
+
+	namespace := t.Name()
+
+	c, err := NewClient(WithNamespace(namespace))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := c.CreateUser(&User{Email: "ford@prefect.co", Name: "Ford Prefect", Age: 200}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := c.UpdateUser(&User{Email: "ford@prefect.co", Name: "Arthur Dent", Age: 42}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err := c.ReadUser("ford@prefect.co"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// failed operations are not recorded
+	if err := c.CreateUser(&User{Email: "ford@prefect.co", Name: "Ford Prefect", Age: 200}); err == nil {
+		t.Fatal("expected error creating duplicate user, got none")
+	}
+
+	journal := NamespaceJournal(namespace)
+
+	if journal != c.Journal() {
+		t.Fatal("expected client to record in the namespace journal")
+	}
+
+	entries := journal.Entries()
+	if len(entries) != 3 {
+		t.Fatalf("expected 3 entries, got %d: %+v", len(entries), entries)
+	}
+
+	updates := journal.Query(JournalQuery{Operation: OperationUpdateUser, Key: "ford@prefect.co"})
+	if len(updates) != 1 {
+		t.Fatalf("expected 1 update entry, got %d", len(updates))
+	}
+
+	before, ok := updates[0].Before.(*User)
+	if !ok || before.Name != "Ford Prefect" || before.Version != 1 {
+		t.Errorf("unexpected before value: %+v", updates[0].Before)
+	}
+
+	after, ok := updates[0].After.(*User)
+	if !ok || after.Name != "Arthur Dent" || after.Version != 2 {
+		t.Errorf("unexpected after value: %+v", updates[0].After)
+	}
+
+	if deletes := journal.Query(JournalQuery{Operation: OperationDeleteUser}); len(deletes) != 0 {
+		t.Errorf("expected no delete entries, got %+v", deletes)
+	}
+
+	if err := ResetNamespace(namespace); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if entries := NamespaceJournal(namespace).Entries(); len(entries) != 0 {
+		t.Errorf("expected reset namespace journal to be empty, got %+v", entries)
+	}
+}
+
+func TestJournal_caller(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewClient(WithFreshDatabase())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err := c.ReadRegions(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	c.Journal().RecordCallers(true)
+
+	if _, err := c.ReadRegions(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	entries := c.Journal().Entries()
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(entries))
+	}
+
+	if entries[0].Caller != "" {
+		t.Errorf("expected no caller before callers are recorded, got %q", entries[0].Caller)
+	}
+
+	entries = entries[1:]
+
+	// frames in this package, including its tests, are skipped
+	if entries[0].Caller != "testing.tRunner" {
+		t.Errorf("expected testing.tRunner caller, got %q", entries[0].Caller)
+	}
+
+	if regions, ok := entries[0].After.([]*Region); !ok || len(regions) != 3 {
+		t.Errorf("unexpected after value: %+v", entries[0].After)
+	}
+}
+
+func TestJournal_size(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewClient(WithFreshDatabase())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for i := range DefaultJournalSize + 5 {
+		if _, err := c.ReadUser(fmt.Sprintf("%d@example.com", i)); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	entries := c.Journal().Entries()
+	if len(entries) != DefaultJournalSize {
+		t.Fatalf("expected %d entries, got %d", DefaultJournalSize, len(entries))
+	}
+
+	// the oldest entries are dropped
+	if entries[0].Key != "5@example.com" {
+		t.Errorf("expected oldest entry for 5@example.com, got %q", entries[0].Key)
+	}
+
+	if last := entries[len(entries)-1].Key; last != fmt.Sprintf("%d@example.com", DefaultJournalSize+4) {
+		t.Errorf("expected newest entry for %d@example.com, got %q", DefaultJournalSize+4, last)
+	}
+}
@@ -0,0 +1,283 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package backend
+
+import (
+	"cmp"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/go-memdb"
+)
+
+// UserSortField names the field ListUsers sorts by. Users with equal values
+// are sorted by email, so the order is always stable.
+type UserSortField string
+
+const (
+	UserSortEmail UserSortField = "email"
+	UserSortName  UserSortField = "name"
+	UserSortAge   UserSortField = "age"
+)
+
+// ListUsersRequest selects a page of users. Zero fields do not filter.
+type ListUsersRequest struct {
+	// MinAge and MaxAge select users within the inclusive age range.
+	MinAge *int
+	MaxAge *int
+
+	Language    string
+	EmailPrefix string
+
+	// SortBy defaults to UserSortEmail.
+	SortBy     UserSortField
+	Descending bool
+
+	// PageSize defaults to 100, and can be at most MaxPageSize.
+	PageSize int
+
+	// PageToken is the NextPageToken of the previous page, and must be
+	// used with the same sort order. Pages can be requested with different
+	// filters, in which case the page starts after the last user of the
+	// previous page.
+	PageToken string
+}
+
+// ListUsersPage is a page of users returned by ListUsers.
+type ListUsersPage struct {
+	Users []*User
+
+	// NextPageToken is empty on the last page.
+	NextPageToken string
+
+	// TotalCount is the number of users matching the filters, across all
+	// pages.
+	TotalCount int
+}
+
+// ListUsers returns a page of the users matching the request.
+func (c *Client) ListUsers(req ListUsersRequest) (*ListUsersPage, error) {
+	var page *ListUsersPage
+
+	err := c.faulted(OperationListUsers, func() error {
+		if c.remote != nil {
+			var err error
+			page, err = c.remote.listUsers(req)
+
+			return err
+		}
+
+		return c.persisted(false, func() error {
+			var err error
+			page, err = c.listUsers(req)
+			if err != nil {
+				return err
+			}
+
+			c.journal.record(OperationListUsers, "", nil, slices.Clone(page.Users))
+
+			return nil
+		})
+	})
+
+	return page, err
+}
+
+// userCursor is the position after which the next page starts, encoded in
+// page tokens.
+type userCursor struct {
+	SortBy     UserSortField `json:"s"`
+	Descending bool          `json:"d,omitempty"`
+	Email      string        `json:"e"`
+	Name       string        `json:"n,omitempty"`
+	Age        int           `json:"a,omitempty"`
+}
+
+func encodePageToken(cursor userCursor) (string, error) {
+	b, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func decodePageToken(token string) (userCursor, error) {
+	var cursor userCursor
+
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return cursor, fmt.Errorf("Invalid page token %q: %s", token, err)
+	}
+
+	if err := json.Unmarshal(b, &cursor); err != nil {
+		return cursor, fmt.Errorf("Invalid page token %q: %s", token, err)
+	}
+
+	return cursor, nil
+}
+
+// compareUsers orders users by the sort field, then by email.
+func compareUsers(sortBy UserSortField, a *User, b *User) int {
+	var c int
+
+	switch sortBy {
+	case UserSortName:
+		c = cmp.Compare(a.Name, b.Name)
+	case UserSortAge:
+		c = cmp.Compare(a.Age, b.Age)
+	}
+
+	if c != 0 {
+		return c
+	}
+
+	return cmp.Compare(a.Email, b.Email)
+}
+
+func (r ListUsersRequest) matches(u *User) bool {
+	if r.MinAge != nil && u.Age < *r.MinAge {
+		return false
+	}
+
+	if r.MaxAge != nil && u.Age > *r.MaxAge {
+		return false
+	}
+
+	if r.Language != "" && u.Language != r.Language {
+		return false
+	}
+
+	return strings.HasPrefix(u.Email, r.EmailPrefix)
+}
+
+func (c *Client) listUsers(req ListUsersRequest) (*ListUsersPage, error) {
+	sortBy := req.SortBy
+	if sortBy == "" {
+		sortBy = UserSortEmail
+	}
+
+	switch sortBy {
+	case UserSortEmail, UserSortName, UserSortAge:
+	default:
+		return nil, fmt.Errorf("Invalid sort field %q: expected email, name or age", sortBy)
+	}
+
+	pageSize := req.PageSize
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+
+	if pageSize < 1 || pageSize > MaxPageSize {
+		return nil, fmt.Errorf("Invalid page size %d: expected a number from 1 to %d", req.PageSize, MaxPageSize)
+	}
+
+	var after *userCursor
+	if req.PageToken != "" {
+		cursor, err := decodePageToken(req.PageToken)
+		if err != nil {
+			return nil, err
+		}
+
+		if cursor.SortBy != sortBy || cursor.Descending != req.Descending {
+			return nil, fmt.Errorf("Invalid page token %q: the sort order of the request has changed", req.PageToken)
+		}
+
+		after = &cursor
+	}
+
+	txn := c.db.Txn(false)
+	defer txn.Abort()
+
+	// Scan the narrowest index for the filters. The age index is ordered by
+	// age, so the scan can stop after the maximum age.
+	var it memdb.ResultIterator
+	var err error
+
+	byAge := req.MinAge != nil || req.MaxAge != nil
+
+	switch {
+	case byAge:
+		minAge := math.MinInt
+		if req.MinAge != nil {
+			minAge = *req.MinAge
+		}
+
+		it, err = txn.LowerBound("users", "age", minAge)
+	case req.EmailPrefix != "":
+		it, err = txn.Get("users", "id_prefix", req.EmailPrefix)
+	default:
+		it, err = txn.Get("users", "id")
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	users := []*User{}
+
+	for obj := it.Next(); obj != nil; obj = it.Next() {
+		u, ok := obj.(*User)
+		if !ok {
+			return nil, fmt.Errorf("unexpected type %T while listing users", obj)
+		}
+
+		if byAge && req.MaxAge != nil && u.Age > *req.MaxAge {
+			break
+		}
+
+		if req.matches(u) {
+			users = append(users, u)
+		}
+	}
+
+	compare := func(a *User, b *User) int {
+		if req.Descending {
+			return compareUsers(sortBy, b, a)
+		}
+
+		return compareUsers(sortBy, a, b)
+	}
+
+	slices.SortFunc(users, compare)
+
+	page := &ListUsersPage{
+		TotalCount: len(users),
+	}
+
+	start := 0
+	if after != nil {
+		cursorUser := &User{Email: after.Email, Name: after.Name, Age: after.Age}
+
+		start, _ = slices.BinarySearchFunc(users, cursorUser, compare)
+		if start < len(users) && compare(users[start], cursorUser) == 0 {
+			start++
+		}
+	}
+
+	end := min(start+pageSize, len(users))
+
+	page.Users = users[start:end]
+
+	if end < len(users) {
+		last := users[end-1]
+
+		page.NextPageToken, err = encodePageToken(userCursor{
+			SortBy:     sortBy,
+			Descending: req.Descending,
+			Email:      last.Email,
+			Name:       last.Name,
+			Age:        last.Age,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return page, nil
+}
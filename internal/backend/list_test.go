@@ -0,0 +1,212 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package backend
+
+import (
+	"slices"
+	"testing"
+)
+
+func newListTestClient(t *testing.T) *Client {
+	t.Helper()
+
+	c, err := NewClient(WithFreshDatabase())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	users := []*User{
+		{Email: "arthur@dent.co", Name: "Arthur Dent", Age: 42},
+		{Email: "ford@prefect.co", Name: "Ford Prefect", Age: 200},
+		{Email: "trillian@astra.co", Name: "Trillian", Age: 30, Language: "es"},
+		{Email: "zaphod@beeblebrox.co", Name: "Zaphod Beeblebrox", Age: 200},
+		{Email: "marvin@sirius.co", Name: "Marvin", Age: 42},
+		{Email: "fenchurch@earth.co", Name: "Fenchurch", Age: 30, Language: "es"},
+	}
+
+	for _, u := range users {
+		if err := c.CreateUser(u); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	return c
+}
+
+func emails(users []*User) []string {
+	var emails []string
+
+	for _, u := range users {
+		emails = append(emails, u.Email)
+	}
+
+	return emails
+}
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func TestClient_ListUsers(t *testing.T) {
+	t.Parallel()
+
+	c := newListTestClient(t)
+
+	testCases := map[string]struct {
+		req      ListUsersRequest
+		expected []string
+	}{
+		"all": {
+			expected: []string{
+				"arthur@dent.co",
+				"fenchurch@earth.co",
+				"ford@prefect.co",
+				"marvin@sirius.co",
+				"trillian@astra.co",
+				"zaphod@beeblebrox.co",
+			},
+		},
+		"age-range": {
+			req: ListUsersRequest{MinAge: intPtr(30), MaxAge: intPtr(42)},
+			expected: []string{
+				"arthur@dent.co",
+				"fenchurch@earth.co",
+				"marvin@sirius.co",
+				"trillian@astra.co",
+			},
+		},
+		"max-age": {
+			req:      ListUsersRequest{MaxAge: intPtr(30)},
+			expected: []string{"fenchurch@earth.co", "trillian@astra.co"},
+		},
+		"language": {
+			req:      ListUsersRequest{Language: "es"},
+			expected: []string{"fenchurch@earth.co", "trillian@astra.co"},
+		},
+		"email-prefix": {
+			req:      ListUsersRequest{EmailPrefix: "f"},
+			expected: []string{"fenchurch@earth.co", "ford@prefect.co"},
+		},
+		"combined": {
+			req:      ListUsersRequest{MinAge: intPtr(40), EmailPrefix: "z"},
+			expected: []string{"zaphod@beeblebrox.co"},
+		},
+		"sort-age": {
+			req: ListUsersRequest{SortBy: UserSortAge},
+			expected: []string{
+				"fenchurch@earth.co",
+				"trillian@astra.co",
+				"arthur@dent.co",
+				"marvin@sirius.co",
+				"ford@prefect.co",
+				"zaphod@beeblebrox.co",
+			},
+		},
+		"sort-age-descending": {
+			req: ListUsersRequest{SortBy: UserSortAge, Descending: true},
+			expected: []string{
+				"zaphod@beeblebrox.co",
+				"ford@prefect.co",
+				"marvin@sirius.co",
+				"arthur@dent.co",
+				"trillian@astra.co",
+				"fenchurch@earth.co",
+			},
+		},
+		"sort-name": {
+			req:      ListUsersRequest{SortBy: UserSortName, Language: "es"},
+			expected: []string{"fenchurch@earth.co", "trillian@astra.co"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			page, err := c.ListUsers(testCase.req)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := emails(page.Users); !slices.Equal(got, testCase.expected) {
+				t.Errorf("expected %q, got: %q", testCase.expected, got)
+			}
+
+			if page.TotalCount != len(testCase.expected) {
+				t.Errorf("expected total count %d, got: %d", len(testCase.expected), page.TotalCount)
+			}
+
+			if page.NextPageToken != "" {
+				t.Errorf("expected no next page, got: %q", page.NextPageToken)
+			}
+		})
+	}
+}
+
+func TestClient_ListUsers_pagination(t *testing.T) {
+	t.Parallel()
+
+	c := newListTestClient(t)
+
+	all, err := c.ListUsers(ListUsersRequest{SortBy: UserSortAge, Descending: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	req := ListUsersRequest{
+		SortBy:     UserSortAge,
+		Descending: true,
+		PageSize:   4,
+	}
+
+	var pages [][]string
+
+	for {
+		page, err := c.ListUsers(req)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		pages = append(pages, emails(page.Users))
+
+		if page.NextPageToken == "" {
+			break
+		}
+
+		req.PageToken = page.NextPageToken
+
+		// users created between pages are listed in their sorted position
+		if len(pages) == 1 {
+			if err := c.CreateUser(&User{Email: "agrajag@bob.co", Name: "Agrajag", Age: 1}); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		}
+	}
+
+	if len(pages) != 2 {
+		t.Fatalf("expected 2 pages, got: %q", pages)
+	}
+
+	expected := append(emails(all.Users), "agrajag@bob.co")
+
+	if got := slices.Concat(pages...); !slices.Equal(got, expected) {
+		t.Errorf("expected %q, got: %q", expected, got)
+	}
+
+	if _, err := c.ListUsers(ListUsersRequest{SortBy: UserSortName, PageToken: req.PageToken}); err == nil {
+		t.Error("expected error for page token of a different sort order, got none")
+	}
+
+	if _, err := c.ListUsers(ListUsersRequest{PageToken: "not a token"}); err == nil {
+		t.Error("expected error for invalid page token, got none")
+	}
+
+	if _, err := c.ListUsers(ListUsersRequest{PageSize: MaxPageSize + 1}); err == nil {
+		t.Error("expected error for page size above maximum, got none")
+	}
+
+	if _, err := c.ListUsers(ListUsersRequest{SortBy: "date_joined"}); err == nil {
+		t.Error("expected error for unknown sort field, got none")
+	}
+}
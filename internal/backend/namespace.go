@@ -0,0 +1,72 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package backend
+
+import (
+	"sync"
+
+	"github.com/hashicorp/go-memdb"
+)
+
+// DefaultNamespace is the namespace used by clients that are not created
+// with WithNamespace.
+const DefaultNamespace = ""
+
+var (
+	namespacesMu sync.Mutex
+	namespaces   = map[string]*memdb.MemDB{}
+)
+
+// ResetNamespace replaces the database and journal of the named namespace
+// with empty ones. Existing clients in the namespace keep using the previous
+// database and journal.
+func ResetNamespace(namespace string) error {
+	db, err := newDB()
+	if err != nil {
+		return err
+	}
+
+	namespacesMu.Lock()
+	defer namespacesMu.Unlock()
+
+	namespaces[namespace] = db
+	journals[namespace] = &Journal{}
+
+	return nil
+}
+
+// DropNamespace removes the named namespace, so that the next client created
+// in it starts with an empty database. Dropping the DefaultNamespace resets
+// it instead.
+func DropNamespace(namespace string) error {
+	if namespace == DefaultNamespace {
+		return ResetNamespace(namespace)
+	}
+
+	namespacesMu.Lock()
+	defer namespacesMu.Unlock()
+
+	delete(namespaces, namespace)
+	delete(journals, namespace)
+
+	return nil
+}
+
+func namespaceDB(namespace string) (*memdb.MemDB, error) {
+	namespacesMu.Lock()
+	defer namespacesMu.Unlock()
+
+	if db, ok := namespaces[namespace]; ok {
+		return db, nil
+	}
+
+	db, err := newDB()
+	if err != nil {
+		return nil, err
+	}
+
+	namespaces[namespace] = db
+
+	return db, nil
+}
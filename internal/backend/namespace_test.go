@@ -0,0 +1,194 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package backend
+
+import (
+	"testing"
+)
+
+// testNamespace returns the namespace name, dropping the namespace when the
+// test finishes so that repeated runs start from an empty database.
+func testNamespace(t *testing.T, name string) string {
+	t.Helper()
+
+	t.Cleanup(func() {
+		if err := DropNamespace(name); err != nil {
+			t.Errorf("unexpected error dropping namespace %q: %s", name, err)
+		}
+	})
+
+	return name
+}
+
+func TestNewClient_namespaceIsolation(t *testing.T) {
+	t.Parallel()
+
+	clientA, err := NewClient(WithNamespace(testNamespace(t, t.Name()+"-a")))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	clientB, err := NewClient(WithNamespace(testNamespace(t, t.Name()+"-b")))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, c := range []*Client{clientA, clientB} {
+		err := c.CreateUser(&User{Email: "ford@prefect.co", Name: "Ford Prefect", Age: 200})
+		if err != nil {
+			t.Fatalf("unexpected error creating user in namespace %q: %s", c.Namespace(), err)
+		}
+	}
+
+	err = clientA.UpdateUser(&User{Email: "ford@prefect.co", Name: "Arthur Dent", Age: 42})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got, err := clientB.ReadUser("ford@prefect.co")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got == nil || got.Name != "Ford Prefect" {
+		t.Fatalf("expected user in namespace %q to be unchanged, got %+v", clientB.Namespace(), got)
+	}
+}
+
+func TestNewClient_namespaceShared(t *testing.T) {
+	t.Parallel()
+
+	namespace := testNamespace(t, t.Name())
+
+	clientA, err := NewClient(WithNamespace(namespace))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	clientB, err := NewClient(WithNamespace(namespace))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	err = clientA.CreateUser(&User{Email: "ford@prefect.co", Name: "Ford Prefect", Age: 200})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got, err := clientB.ReadUser("ford@prefect.co")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got == nil {
+		t.Fatal("expected user to be visible to client in the same namespace")
+	}
+}
+
+func TestNewClient_freshDatabase(t *testing.T) {
+	t.Parallel()
+
+	namespace := testNamespace(t, t.Name())
+
+	shared, err := NewClient(WithNamespace(namespace))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	err = shared.CreateUser(&User{Email: "ford@prefect.co", Name: "Ford Prefect", Age: 200})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	fresh, err := NewClient(WithNamespace(namespace), WithFreshDatabase())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got, err := fresh.ReadUser("ford@prefect.co")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got != nil {
+		t.Fatalf("expected fresh database to be empty, got %+v", got)
+	}
+
+	regions, err := fresh.ReadRegions()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(regions) != 3 {
+		t.Fatalf("expected fresh database to contain 3 regions, got %d", len(regions))
+	}
+}
+
+func TestResetNamespace(t *testing.T) {
+	t.Parallel()
+
+	namespace := testNamespace(t, t.Name())
+
+	c, err := NewClient(WithNamespace(namespace))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	err = c.CreateUser(&User{Email: "ford@prefect.co", Name: "Ford Prefect", Age: 200})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := ResetNamespace(namespace); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	c, err = NewClient(WithNamespace(namespace))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got, err := c.ReadUser("ford@prefect.co")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got != nil {
+		t.Fatalf("expected reset namespace to be empty, got %+v", got)
+	}
+}
+
+func TestDropNamespace(t *testing.T) {
+	t.Parallel()
+
+	namespace := testNamespace(t, t.Name())
+
+	c, err := NewClient(WithNamespace(namespace))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	err = c.CreateUser(&User{Email: "ford@prefect.co", Name: "Ford Prefect", Age: 200})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := DropNamespace(namespace); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	c, err = NewClient(WithNamespace(namespace))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got, err := c.ReadUser("ford@prefect.co")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got != nil {
+		t.Fatalf("expected dropped namespace to be empty, got %+v", got)
+	}
+}
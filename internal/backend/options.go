@@ -0,0 +1,138 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package backend
+
+import (
+	"net/http"
+	"time"
+)
+
+type clientOptions struct {
+	namespace       string
+	fresh           bool
+	persistencePath string
+	faults          *FaultInjector
+
+	readAfterWriteDelay time.Duration
+	softDeleteRetention time.Duration
+
+	rateLimit float64
+	rateBurst int
+	quotas    map[string]int
+
+	endpoint   string
+	httpClient *http.Client
+	userAgent  string
+}
+
+// Option configures a Client returned by NewClient.
+type Option func(*clientOptions)
+
+// WithNamespace returns an Option that isolates the client in the named
+// namespace. Clients created with the same namespace share a database, while
+// clients in different namespaces never see each other's records. The
+// namespace database is created on first use.
+func WithNamespace(namespace string) Option {
+	return func(o *clientOptions) {
+		o.namespace = namespace
+	}
+}
+
+// WithFreshDatabase returns an Option that gives the client its own empty
+// database, which is not shared with any other client.
+func WithFreshDatabase() Option {
+	return func(o *clientOptions) {
+		o.fresh = true
+	}
+}
+
+// WithPersistence returns an Option that backs the client with the JSON file
+// at path. Every table is loaded from the file before every operation and
+// written back after every change, so that separate provider processes
+// observe the same records. When the option is not given, the
+// path is read from the PersistencePathEnvVar environment variable.
+func WithPersistence(path string) Option {
+	return func(o *clientOptions) {
+		o.persistencePath = path
+	}
+}
+
+// WithFaultInjector returns an Option that applies the rules of the
+// FaultInjector to every operation of the client.
+func WithFaultInjector(f *FaultInjector) Option {
+	return func(o *clientOptions) {
+		o.faults = f
+	}
+}
+
+// WithReadAfterWriteDelay returns an Option that makes the client eventually
+// consistent: for the given delay after a user is created, updated or
+// deleted, ReadUser returns the user as it was before the write. In
+// particular, ReadUser returns nil right after CreateUser.
+func WithReadAfterWriteDelay(delay time.Duration) Option {
+	return func(o *clientOptions) {
+		o.readAfterWriteDelay = delay
+	}
+}
+
+// WithSoftDelete returns an Option that makes DeleteUser keep a Tombstone of
+// the deleted user for the retention. Until the tombstone expires, the user
+// can be restored with RestoreUser, and CreateUser of the same email returns
+// a *TombstoneError.
+func WithSoftDelete(retention time.Duration) Option {
+	return func(o *clientOptions) {
+		o.softDeleteRetention = retention
+	}
+}
+
+// WithRateLimit returns an Option that allows the client limit operations per
+// second on average, in bursts of up to burst operations. Operations over
+// the limit return a *ThrottlingError without being performed.
+func WithRateLimit(limit float64, burst int) Option {
+	return func(o *clientOptions) {
+		o.rateLimit = limit
+		o.rateBurst = burst
+	}
+}
+
+// WithQuota returns an Option that limits the number of records in the table,
+// which is one of users, groups or memberships. Creating a record over the
+// limit returns a *QuotaError.
+func WithQuota(table string, limit int) Option {
+	return func(o *clientOptions) {
+		if o.quotas == nil {
+			o.quotas = map[string]int{}
+		}
+
+		o.quotas[table] = limit
+	}
+}
+
+// WithEndpoint returns an Option that makes the client perform every
+// operation against the server at the URL, such as one hosting NewHandler,
+// instead of a local database. The namespace, persistence, read-after-write
+// delay, soft delete and quotas of the client are then those of the server,
+// and the options for them are ignored. Faults are still injected, and the
+// rate limit still applied, by the client.
+func WithEndpoint(endpoint string) Option {
+	return func(o *clientOptions) {
+		o.endpoint = endpoint
+	}
+}
+
+// WithHTTPClient returns an Option that sends the requests of a client
+// created with WithEndpoint using the http.Client.
+func WithHTTPClient(client *http.Client) Option {
+	return func(o *clientOptions) {
+		o.httpClient = client
+	}
+}
+
+// WithUserAgent returns an Option that sets the User-Agent header of the
+// requests of a client created with WithEndpoint.
+func WithUserAgent(userAgent string) Option {
+	return func(o *clientOptions) {
+		o.userAgent = userAgent
+	}
+}
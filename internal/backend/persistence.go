@@ -0,0 +1,332 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package backend
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-memdb"
+)
+
+// PersistencePathEnvVar is the environment variable read by NewClient for the
+// path of the JSON file backing the client, when WithPersistence is not given.
+const PersistencePathEnvVar = "CORNER_BACKEND_PERSISTENCE_PATH"
+
+const (
+	// persistenceLockTimeout is how long to wait for another process to
+	// release the lock file before giving up.
+	persistenceLockTimeout = 10 * time.Second
+
+	// persistenceLockStale is how old a lock file must be before it is
+	// assumed to have been left behind by a crashed process.
+	persistenceLockStale = 30 * time.Second
+
+	// persistenceLockRefreshInterval is how often the modification time of a
+	// held lock file is refreshed, so a long write is not taken as stale.
+	persistenceLockRefreshInterval = persistenceLockStale / 3
+
+	persistenceLockPollInterval = 10 * time.Millisecond
+)
+
+// persistenceFile is the JSON document written to the persistence path.
+type persistenceFile struct {
+	Users       []*User       `json:"users"`
+	Regions     []*Region     `json:"regions"`
+	Groups      []*Group      `json:"groups"`
+	Memberships []*Membership `json:"memberships"`
+
+	Operations []*AsyncOperation `json:"operations"`
+	Tombstones []*Tombstone      `json:"tombstones"`
+}
+
+// persistedTables are the tables stored in the persistence file.
+var persistedTables = []string{"users", "regions", "groups", "memberships", "operations", "tombstones"}
+
+type persistence struct {
+	path string
+
+	// mu serializes the load, fn and save of concurrent calls on the client,
+	// as the lock file only serializes them between processes.
+	mu sync.Mutex
+}
+
+// persisted runs fn against the in-memory database. With persistence enabled,
+// the database is first refreshed from the file and, for writes, saved back.
+// Reads and writes both hold the lock file, so a read cannot reload the file
+// between the commit and the save of a write and drop the write.
+func (c *Client) persisted(write bool, fn func() error) error {
+	if c.persistence == nil {
+		return fn()
+	}
+
+	c.persistence.mu.Lock()
+	defer c.persistence.mu.Unlock()
+
+	unlock, err := c.persistence.lock()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if err := c.persistence.load(c.db); err != nil {
+		return err
+	}
+
+	if err := fn(); err != nil {
+		return err
+	}
+
+	if !write {
+		return nil
+	}
+
+	return c.persistence.save(c.db)
+}
+
+func (p *persistence) lockPath() string {
+	return p.path + ".lock"
+}
+
+// lock acquires an exclusive lock file next to the persistence file. Creating
+// the file with O_EXCL is atomic on every platform, unlike advisory locks.
+// While the lock is held, its modification time is refreshed so other
+// processes do not take it over as stale.
+func (p *persistence) lock() (func(), error) {
+	deadline := time.Now().Add(persistenceLockTimeout)
+
+	for {
+		f, err := os.OpenFile(p.lockPath(), os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+		if err == nil {
+			_, _ = f.WriteString(strconv.Itoa(os.Getpid()))
+			_ = f.Close()
+
+			return p.refreshLock(), nil
+		}
+
+		if !errors.Is(err, os.ErrExist) {
+			return nil, fmt.Errorf("Error acquiring lock for %s: %s", p.path, err)
+		}
+
+		if info, statErr := os.Stat(p.lockPath()); statErr == nil && time.Since(info.ModTime()) > persistenceLockStale {
+			p.breakStaleLock()
+
+			continue
+		}
+
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("Timed out acquiring lock for %s: lock file %s is held by another process", p.path, p.lockPath())
+		}
+
+		time.Sleep(persistenceLockPollInterval)
+	}
+}
+
+// refreshLock touches the held lock file every persistenceLockRefreshInterval
+// until the returned unlock function is called, which removes the lock file.
+func (p *persistence) refreshLock() func() {
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+
+	go func() {
+		defer close(stopped)
+
+		ticker := time.NewTicker(persistenceLockRefreshInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				now := time.Now()
+				_ = os.Chtimes(p.lockPath(), now, now)
+			}
+		}
+	}()
+
+	return func() {
+		close(done)
+		<-stopped
+
+		_ = os.Remove(p.lockPath())
+	}
+}
+
+// breakStaleLock removes a stale lock file. Removing it in place would race
+// with another process that also found it stale and has already replaced it
+// with its own lock, so the lock file is first renamed to a name unique to
+// this process, which only one process can do, and then checked again. A lock
+// that turns out to be fresh is put back.
+func (p *persistence) breakStaleLock() {
+	stale := fmt.Sprintf("%s.stale-%d-%d", p.lockPath(), os.Getpid(), time.Now().UnixNano())
+
+	if err := os.Rename(p.lockPath(), stale); err != nil {
+		// another process has already taken over the lock
+		return
+	}
+
+	info, err := os.Stat(stale)
+	if err == nil && time.Since(info.ModTime()) <= persistenceLockStale {
+		_ = os.Rename(stale, p.lockPath())
+
+		return
+	}
+
+	_ = os.Remove(stale)
+}
+
+// load replaces the persisted tables with the contents of the file.
+// A missing file leaves the database untouched.
+func (p *persistence) load(db *memdb.MemDB) error {
+	data, err := os.ReadFile(p.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error reading %s: %s", p.path, err)
+	}
+
+	var file persistenceFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return fmt.Errorf("Error decoding %s: %s", p.path, err)
+	}
+
+	txn := db.Txn(true)
+	defer txn.Abort()
+
+	for _, table := range persistedTables {
+		if _, err := txn.DeleteAll(table, "id"); err != nil {
+			return err
+		}
+	}
+
+	if err := insertAll(txn, "users", file.Users); err != nil {
+		return err
+	}
+
+	if err := insertAll(txn, "regions", file.Regions); err != nil {
+		return err
+	}
+
+	if err := insertAll(txn, "groups", file.Groups); err != nil {
+		return err
+	}
+
+	if err := insertAll(txn, "memberships", file.Memberships); err != nil {
+		return err
+	}
+
+	if err := insertAll(txn, "operations", file.Operations); err != nil {
+		return err
+	}
+
+	if err := insertAll(txn, "tombstones", file.Tombstones); err != nil {
+		return err
+	}
+
+	txn.Commit()
+
+	return nil
+}
+
+// save atomically writes the persisted tables to the file by writing
+// a temporary file in the same directory and renaming it over the original.
+func (p *persistence) save(db *memdb.MemDB) error {
+	var file persistenceFile
+	var err error
+
+	txn := db.Txn(false)
+	defer txn.Abort()
+
+	if file.Users, err = getAll[User](txn, "users"); err != nil {
+		return err
+	}
+
+	if file.Regions, err = getAll[Region](txn, "regions"); err != nil {
+		return err
+	}
+
+	if file.Groups, err = getAll[Group](txn, "groups"); err != nil {
+		return err
+	}
+
+	if file.Memberships, err = getAll[Membership](txn, "memberships"); err != nil {
+		return err
+	}
+
+	if file.Operations, err = getAll[AsyncOperation](txn, "operations"); err != nil {
+		return err
+	}
+
+	if file.Tombstones, err = getAll[Tombstone](txn, "tombstones"); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(p.path), filepath.Base(p.path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("Error saving %s: %s", p.path, err)
+	}
+	defer os.Remove(tmp.Name()) //nolint:errcheck // already renamed on success
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("Error saving %s: %s", p.path, err)
+	}
+
+	if err := tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("Error saving %s: %s", p.path, err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("Error saving %s: %s", p.path, err)
+	}
+
+	if err := os.Rename(tmp.Name(), p.path); err != nil {
+		return fmt.Errorf("Error saving %s: %s", p.path, err)
+	}
+
+	return nil
+}
+
+func insertAll[T any](txn *memdb.Txn, table string, objs []*T) error {
+	for _, obj := range objs {
+		if err := txn.Insert(table, obj); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func getAll[T any](txn *memdb.Txn, table string) ([]*T, error) {
+	var objs []*T
+
+	it, err := txn.Get(table, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	for raw := it.Next(); raw != nil; raw = it.Next() {
+		obj, ok := raw.(*T)
+		if !ok {
+			return nil, fmt.Errorf("unexpected type %T while saving %s", raw, table)
+		}
+		objs = append(objs, obj)
+	}
+
+	return objs, nil
+}
@@ -0,0 +1,295 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package backend
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestWithPersistence_sharedAcrossClients(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "backend.json")
+
+	writer, err := NewClient(WithPersistence(path))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	err = writer.CreateUser(&User{Email: "ford@prefect.co", Name: "Ford Prefect", Age: 200})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	reader, err := NewClient(WithPersistence(path))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got, err := reader.ReadUser("ford@prefect.co")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got == nil || got.Name != "Ford Prefect" {
+		t.Fatalf("expected user to be loaded from %s, got %+v", path, got)
+	}
+
+	err = reader.DeleteUser(got)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got, err = writer.ReadUser("ford@prefect.co")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got != nil {
+		t.Fatalf("expected deleted user to be removed from %s, got %+v", path, got)
+	}
+}
+
+func TestWithPersistence_fileContents(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "backend.json")
+
+	c, err := NewClient(WithPersistence(path))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	err = c.CreateUser(&User{Email: "ford@prefect.co", Name: "Ford Prefect", Age: 200})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var file persistenceFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(file.Users) != 1 || file.Users[0].Email != "ford@prefect.co" {
+		t.Errorf("expected one persisted user, got %+v", file.Users)
+	}
+
+	if len(file.Regions) != 3 {
+		t.Errorf("expected three persisted regions, got %+v", file.Regions)
+	}
+
+	if _, err := os.Stat(path + ".lock"); !os.IsNotExist(err) {
+		t.Errorf("expected lock file to be removed, got: %v", err)
+	}
+}
+
+func TestWithPersistence_environmentVariable(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "backend.json")
+	t.Setenv(PersistencePathEnvVar, path)
+
+	c, err := NewClient()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	err = c.CreateUser(&User{Email: "ford@prefect.co", Name: "Ford Prefect", Age: 200})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err := os.Stat(path); err != nil {
+		t.Fatalf("expected %s to be written: %s", path, err)
+	}
+}
+
+func TestWithPersistence_concurrentWriters(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "backend.json")
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			// Each writer has its own client to mimic separate processes.
+			c, err := NewClient(WithPersistence(path))
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			errs <- c.CreateUser(&User{Email: fmt.Sprintf("user%d@example.com", i), Name: "User", Age: i})
+		}()
+	}
+
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	c, err := NewClient(WithPersistence(path))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for i := 0; i < 10; i++ {
+		email := fmt.Sprintf("user%d@example.com", i)
+
+		got, err := c.ReadUser(email)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if got == nil {
+			t.Errorf("expected user %s to be persisted", email)
+		}
+	}
+}
+
+func TestWithPersistence_concurrentReadsAndWrites(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "backend.json")
+
+	c, err := NewClient(WithPersistence(path))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Reads on the same client must not reload the file between the commit
+	// and the save of a write, which would drop the write.
+	done := make(chan struct{})
+	readErrs := make(chan error, 4)
+
+	for i := 0; i < 4; i++ {
+		go func() {
+			for {
+				select {
+				case <-done:
+					readErrs <- nil
+					return
+				default:
+				}
+
+				if _, err := c.ReadUser("ford@prefect.co"); err != nil {
+					readErrs <- err
+					return
+				}
+			}
+		}()
+	}
+
+	for i := 0; i < 50; i++ {
+		err := c.CreateUser(&User{Email: fmt.Sprintf("user%d@example.com", i), Name: "User", Age: i})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	close(done)
+
+	for i := 0; i < 4; i++ {
+		if err := <-readErrs; err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	reader, err := NewClient(WithPersistence(path))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for i := 0; i < 50; i++ {
+		email := fmt.Sprintf("user%d@example.com", i)
+
+		got, err := reader.ReadUser(email)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if got == nil {
+			t.Errorf("expected user %s to be persisted", email)
+		}
+	}
+}
+
+func TestPersistence_lockStale(t *testing.T) {
+	t.Parallel()
+
+	p := &persistence{path: filepath.Join(t.TempDir(), "backend.json")}
+
+	if err := os.WriteFile(p.lockPath(), []byte("0"), 0o600); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	old := time.Now().Add(-2 * persistenceLockStale)
+	if err := os.Chtimes(p.lockPath(), old, old); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	unlock, err := p.lock()
+	if err != nil {
+		t.Fatalf("expected stale lock to be taken over, got: %s", err)
+	}
+
+	unlock()
+
+	entries, err := os.ReadDir(filepath.Dir(p.path))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(entries) != 0 {
+		t.Errorf("expected no lock files left behind, got: %v", entries)
+	}
+}
+
+// A process that found the lock stale must not remove a lock that another
+// process has taken over in the meantime.
+func TestPersistence_breakStaleLockFresh(t *testing.T) {
+	t.Parallel()
+
+	p := &persistence{path: filepath.Join(t.TempDir(), "backend.json")}
+
+	unlock, err := p.lock()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer unlock()
+
+	p.breakStaleLock()
+
+	if _, err := os.Stat(p.lockPath()); err != nil {
+		t.Fatalf("expected fresh lock to be kept, got: %s", err)
+	}
+
+	matches, err := filepath.Glob(p.lockPath() + ".stale-*")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(matches) != 0 {
+		t.Errorf("expected no stale lock files left behind, got: %v", matches)
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package backend
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-memdb"
+)
+
+// quotaRetryAfter is the retry-after hint of a QuotaError.
+const quotaRetryAfter = time.Second
+
+// quotaTables are the tables that WithQuota can limit.
+var quotaTables = []string{"users", "groups", "memberships"}
+
+// rateLimiter is a token bucket, refilled at rate tokens per second up to
+// burst tokens. Every operation takes a token. It is safe for concurrent use.
+type rateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newRateLimiter(rate float64, burst int) *rateLimiter {
+	if rate <= 0 {
+		return nil
+	}
+
+	b := math.Max(float64(burst), 1)
+
+	return &rateLimiter{
+		rate:   rate,
+		burst:  b,
+		tokens: b,
+		last:   time.Now(),
+	}
+}
+
+// take takes a token from the bucket, returning zero, or how long until the
+// next token is available if the bucket is empty. A nil rateLimiter always
+// has tokens.
+func (l *rateLimiter) take() time.Duration {
+	if l == nil {
+		return 0
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+
+	if l.tokens >= 1 {
+		l.tokens--
+
+		return 0
+	}
+
+	return time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+}
+
+// throttle returns a *ThrottlingError if the operation exceeds the rate
+// limit of the client.
+func (c *Client) throttle(op Operation) error {
+	wait := c.limiter.take()
+	if wait == 0 {
+		return nil
+	}
+
+	return &ThrottlingError{
+		Operation:  op,
+		RetryAfter: wait,
+	}
+}
+
+// checkQuota returns a *QuotaError if inserting another record into the
+// table would exceed the quota of the client.
+func (c *Client) checkQuota(txn *memdb.Txn, table string) error {
+	limit, ok := c.quotas[table]
+	if !ok {
+		return nil
+	}
+
+	it, err := txn.Get(table, "id")
+	if err != nil {
+		return err
+	}
+
+	count := 0
+	for obj := it.Next(); obj != nil; obj = it.Next() {
+		count++
+	}
+
+	if count < limit {
+		return nil
+	}
+
+	return &QuotaError{
+		Table:      table,
+		Limit:      limit,
+		RetryAfter: quotaRetryAfter,
+	}
+}
+
+func validateQuotas(quotas map[string]int) error {
+	for table, limit := range quotas {
+		if !slices.Contains(quotaTables, table) {
+			return fmt.Errorf("Invalid quota for table %q: expected one of %q", table, quotaTables)
+		}
+
+		if limit < 0 {
+			return fmt.Errorf("Invalid quota for table %q: limit must not be negative", table)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,138 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package backend
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestClient_rateLimit(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewClient(WithFreshDatabase(), WithRateLimit(10, 2))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for range 2 {
+		if _, err := c.ReadRegions(); err != nil {
+			t.Fatalf("expected burst to be allowed, got: %s", err)
+		}
+	}
+
+	_, err = c.ReadRegions()
+
+	var throttlingErr *ThrottlingError
+	if !errors.As(err, &throttlingErr) {
+		t.Fatalf("expected ThrottlingError, got: %v", err)
+	}
+
+	if throttlingErr.Operation != OperationReadRegions {
+		t.Errorf("expected operation %s, got: %s", OperationReadRegions, throttlingErr.Operation)
+	}
+
+	after, ok := RetryAfter(err)
+	if !ok || after <= 0 || after > 100*time.Millisecond {
+		t.Fatalf("expected retry after up to 100ms, got: %s", after)
+	}
+
+	time.Sleep(after)
+
+	if _, err := c.ReadRegions(); err != nil {
+		t.Errorf("expected operation to be allowed after %s, got: %s", after, err)
+	}
+}
+
+func TestClient_quota(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewClient(WithFreshDatabase(), WithQuota("users", 1), WithQuota("groups", 0))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	user := &User{Email: "ford@prefect.co", Name: "Ford Prefect", Age: 200}
+	if err := c.CreateUser(user); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	err = c.CreateUser(&User{Email: "arthur@dent.co", Name: "Arthur Dent", Age: 30})
+
+	var quotaErr *QuotaError
+	if !errors.As(err, &quotaErr) {
+		t.Fatalf("expected QuotaError, got: %v", err)
+	}
+
+	if quotaErr.Table != "users" || quotaErr.Limit != 1 {
+		t.Errorf("unexpected quota error: %+v", quotaErr)
+	}
+
+	if quotaErr.RetryAfter != quotaRetryAfter {
+		t.Errorf("expected retry after %s, got: %s", quotaRetryAfter, quotaErr.RetryAfter)
+	}
+
+	if _, ok := RetryAfter(err); ok {
+		t.Error("expected quota error not to be retryable")
+	}
+
+	if err := c.CreateGroup(&Group{Name: "hitchhikers"}); !errors.As(err, &quotaErr) {
+		t.Errorf("expected QuotaError creating group, got: %v", err)
+	}
+
+	if err := c.DeleteUser(user); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := c.CreateUser(&User{Email: "arthur@dent.co", Name: "Arthur Dent", Age: 30}); err != nil {
+		t.Errorf("expected user to be created once under quota, got: %s", err)
+	}
+}
+
+func TestNewClient_invalidQuota(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NewClient(WithFreshDatabase(), WithQuota("regions", 1)); err == nil {
+		t.Error("expected error for quota of unknown table, got none")
+	}
+
+	if _, err := NewClient(WithFreshDatabase(), WithQuota("users", -1)); err == nil {
+		t.Error("expected error for negative quota, got none")
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	if _, ok := RetryAfter(errors.New("connection reset")); ok {
+		t.Error("expected no retry after for other errors")
+	}
+
+	if _, ok := RetryAfter(nil); ok {
+		t.Error("expected no retry after for nil")
+	}
+}
+
+func TestHTTPClient_quota(t *testing.T) {
+	t.Parallel()
+
+	server, err := NewClient(WithFreshDatabase(), WithQuota("users", 0))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	c := newTestServer(t, server)
+
+	err = c.CreateUser(&User{Email: "ford@prefect.co", Name: "Ford Prefect", Age: 200})
+
+	var quotaErr *QuotaError
+	if !errors.As(err, &quotaErr) {
+		t.Fatalf("expected QuotaError, got: %v", err)
+	}
+
+	if quotaErr.Limit != 0 || quotaErr.RetryAfter != quotaRetryAfter {
+		t.Errorf("unexpected quota error: %+v", quotaErr)
+	}
+}
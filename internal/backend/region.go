@@ -0,0 +1,221 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package backend
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-memdb"
+)
+
+// CreateRegion inserts a new region, erroring if a region with the same name
+// already exists.
+func (c *Client) CreateRegion(region *Region) error {
+	return c.faulted(OperationCreateRegion, func() error {
+		if c.remote != nil {
+			return c.remote.createRegion(region)
+		}
+
+		return c.persisted(true, func() error {
+			return c.createRegion(region)
+		})
+	})
+}
+
+// ReadRegion returns the region with the given name, or nil if there is none.
+func (c *Client) ReadRegion(name string) (*Region, error) {
+	var region *Region
+
+	err := c.faulted(OperationReadRegion, func() error {
+		if c.remote != nil {
+			var err error
+			region, err = c.remote.readRegion(name)
+
+			return err
+		}
+
+		return c.persisted(false, func() error {
+			var err error
+			region, err = c.readRegion(name)
+			if err != nil {
+				return err
+			}
+
+			c.journal.record(OperationReadRegion, name, nil, clone(region))
+
+			return nil
+		})
+	})
+
+	return region, err
+}
+
+// UpdateRegion overwrites the description of an existing region.
+func (c *Client) UpdateRegion(region *Region) error {
+	return c.faulted(OperationUpdateRegion, func() error {
+		if c.remote != nil {
+			return c.remote.updateRegion(region)
+		}
+
+		return c.persisted(true, func() error {
+			return c.updateRegion(region)
+		})
+	})
+}
+
+// DeleteRegion removes an existing region, erroring with an *InUseError if
+// users are still in it.
+func (c *Client) DeleteRegion(region *Region) error {
+	return c.faulted(OperationDeleteRegion, func() error {
+		if c.remote != nil {
+			return c.remote.deleteRegion(region)
+		}
+
+		return c.persisted(true, func() error {
+			return c.deleteRegion(region)
+		})
+	})
+}
+
+func (c *Client) createRegion(region *Region) error {
+	txn := c.db.Txn(true)
+	defer txn.Abort()
+
+	// uniqueness: error if name already exists in db
+	existing, err := txn.First("regions", "id", region.Name)
+	if err != nil {
+		return fmt.Errorf("Error determining if region %s already exists in db: %s", region.Name, err)
+	}
+
+	if existing != nil {
+		return fmt.Errorf("Cannot create region: region already exists with name %s", region.Name)
+	}
+
+	// copy, as the caller may modify region after it is stored
+	if err := txn.Insert("regions", clone(region)); err != nil {
+		return err
+	}
+
+	txn.Commit()
+
+	c.journal.record(OperationCreateRegion, region.Name, nil, clone(region))
+
+	return nil
+}
+
+func (c *Client) readRegion(name string) (*Region, error) {
+	txn := c.db.Txn(false)
+	defer txn.Abort()
+
+	raw, err := txn.First("regions", "id", name)
+	if err != nil {
+		return nil, err
+	}
+
+	if raw == nil {
+		return nil, nil
+	}
+
+	r, ok := raw.(*Region)
+	if !ok {
+		return nil, fmt.Errorf("unexpected type %T while reading region", raw)
+	}
+
+	return r, nil
+}
+
+func (c *Client) updateRegion(region *Region) error {
+	txn := c.db.Txn(true)
+	defer txn.Abort()
+
+	raw, err := txn.First("regions", "id", region.Name)
+	if err != nil {
+		return err
+	}
+
+	if raw == nil {
+		return fmt.Errorf("Cannot update region %s: name not in db", region.Name)
+	}
+
+	existing, ok := raw.(*Region)
+	if !ok {
+		return fmt.Errorf("unexpected type %T while updating region", raw)
+	}
+
+	// copy, as objects in memdb must not be modified in place
+	r := *existing
+	r.Description = region.Description
+
+	if err := txn.Insert("regions", &r); err != nil {
+		return err
+	}
+
+	txn.Commit()
+
+	c.journal.record(OperationUpdateRegion, region.Name, clone(existing), clone(&r))
+
+	return nil
+}
+
+func (c *Client) deleteRegion(region *Region) error {
+	txn := c.db.Txn(true)
+	defer txn.Abort()
+
+	raw, err := txn.First("regions", "id", region.Name)
+	if err != nil {
+		return fmt.Errorf("Error determining if region %s exists in db: %s", region.Name, err)
+	}
+
+	if raw == nil {
+		return fmt.Errorf("Cannot delete region %s: name not in db", region.Name)
+	}
+
+	existing, ok := raw.(*Region)
+	if !ok {
+		return fmt.Errorf("unexpected type %T while deleting region", raw)
+	}
+
+	// referential integrity: error if users are still in the region
+	user, err := txn.First("users", "region", region.Name)
+	if err != nil {
+		return err
+	}
+
+	if user != nil {
+		return &InUseError{
+			Kind:         "region",
+			Name:         region.Name,
+			ReferencedBy: "user",
+		}
+	}
+
+	if err := txn.Delete("regions", existing); err != nil {
+		return err
+	}
+
+	txn.Commit()
+
+	c.journal.record(OperationDeleteRegion, region.Name, clone(existing), nil)
+
+	return nil
+}
+
+// checkRegion returns an error if the user is in a region that does not
+// exist. Users need not be in a region.
+func checkRegion(txn *memdb.Txn, user *User) error {
+	if user.Region == "" {
+		return nil
+	}
+
+	region, err := txn.First("regions", "id", user.Region)
+	if err != nil {
+		return err
+	}
+
+	if region == nil {
+		return fmt.Errorf("region %s not in db", user.Region)
+	}
+
+	return nil
+}
@@ -0,0 +1,162 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package backend
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestClient_region(t *testing.T) {
+	t.Parallel()
+
+	c := newFreshClient(t)
+
+	if err := c.CreateRegion(&Region{Name: "UK"}); err == nil || !strings.Contains(err.Error(), "region already exists") {
+		t.Errorf("expected error creating duplicate region, got: %v", err)
+	}
+
+	if err := c.CreateRegion(&Region{Name: "Magrathea", Description: "Planet factory"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := c.UpdateRegion(&Region{Name: "Magrathea", Description: "Closed"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	region, err := c.ReadRegion("Magrathea")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if region == nil || region.Description != "Closed" {
+		t.Errorf("unexpected region: %+v", region)
+	}
+
+	regions, err := c.ReadRegions()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(regions) != 4 {
+		t.Errorf("expected 4 regions, got: %d", len(regions))
+	}
+
+	if err := c.DeleteRegion(&Region{Name: "Magrathea"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	region, err = c.ReadRegion("Magrathea")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if region != nil {
+		t.Errorf("expected no region, got: %+v", region)
+	}
+
+	if err := c.UpdateRegion(&Region{Name: "Magrathea"}); err == nil {
+		t.Error("expected error updating missing region, got none")
+	}
+
+	if err := c.DeleteRegion(&Region{Name: "Magrathea"}); err == nil {
+		t.Error("expected error deleting missing region, got none")
+	}
+}
+
+func TestClient_userRegion(t *testing.T) {
+	t.Parallel()
+
+	c := newFreshClient(t)
+
+	user := &User{Email: "ford@prefect.co", Name: "Ford Prefect", Age: 200, Region: "Magrathea"}
+
+	if err := c.CreateUser(user); err == nil || !strings.Contains(err.Error(), "region Magrathea not in db") {
+		t.Fatalf("expected error creating user in missing region, got: %v", err)
+	}
+
+	if err := c.CreateRegion(&Region{Name: "Magrathea"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := c.CreateUser(user); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var inUseErr *InUseError
+
+	if err := c.DeleteRegion(&Region{Name: "Magrathea"}); !errors.As(err, &inUseErr) {
+		t.Fatalf("expected InUseError deleting region with users, got: %v", err)
+	}
+
+	if inUseErr.Kind != "region" || inUseErr.ReferencedBy != "user" {
+		t.Errorf("unexpected in use error: %+v", inUseErr)
+	}
+
+	user.Region = "Betelgeuse"
+	if err := c.UpdateUser(user); err == nil || !strings.Contains(err.Error(), "region Betelgeuse not in db") {
+		t.Errorf("expected error moving user to missing region, got: %v", err)
+	}
+
+	user.Region = "UK"
+	if err := c.UpdateUser(user); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got, err := c.ReadUser("ford@prefect.co")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got == nil || got.Region != "UK" {
+		t.Errorf("expected user to be moved to UK, got: %+v", got)
+	}
+
+	if err := c.DeleteRegion(&Region{Name: "Magrathea"}); err != nil {
+		t.Errorf("expected region without users to be deleted, got: %s", err)
+	}
+}
+
+func TestHTTPClient_regions(t *testing.T) {
+	t.Parallel()
+
+	c := newTestServer(t, nil)
+
+	if err := c.CreateRegion(&Region{Name: "Magrathea", Description: "Planet factory"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := c.UpdateRegion(&Region{Name: "Magrathea", Description: "Closed"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	region, err := c.ReadRegion("Magrathea")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if region == nil || region.Description != "Closed" {
+		t.Errorf("unexpected region: %+v", region)
+	}
+
+	if err := c.CreateUser(&User{Email: "ford@prefect.co", Name: "Ford Prefect", Age: 200, Region: "Magrathea"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var inUseErr *InUseError
+
+	if err := c.DeleteRegion(&Region{Name: "Magrathea"}); !errors.As(err, &inUseErr) {
+		t.Errorf("expected InUseError deleting region with users, got: %v", err)
+	}
+
+	region, err = c.ReadRegion("Betelgeuse")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if region != nil {
+		t.Errorf("expected no region, got: %+v", region)
+	}
+}
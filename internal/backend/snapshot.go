@@ -0,0 +1,86 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package backend
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/go-memdb"
+)
+
+// errSnapshotRemote is returned by Snapshot and Restore of clients created
+// with WithEndpoint, which should instead use a client of the server.
+var errSnapshotRemote = errors.New("Cannot snapshot records through a client created with WithEndpoint: use a client of the server instead")
+
+// Snapshot is a point-in-time copy of every record of a Client, returned by
+// Client.Snapshot. Taking it is cheap, as the database is made of immutable
+// radix trees that the snapshot shares until the records are changed.
+// Neither faults nor the rate limit apply to taking or restoring snapshots.
+type Snapshot struct {
+	db *memdb.MemDB
+}
+
+// Snapshot returns a copy of every record of the client, which Restore can
+// later put back.
+func (c *Client) Snapshot() (*Snapshot, error) {
+	if c.remote != nil {
+		return nil, errSnapshotRemote
+	}
+
+	var snapshot *Snapshot
+
+	err := c.persisted(false, func() error {
+		snapshot = &Snapshot{db: c.db.Snapshot()}
+
+		return nil
+	})
+
+	return snapshot, err
+}
+
+// Restore replaces every record of the client with those of the snapshot.
+// Other clients sharing the database, such as those in the same namespace,
+// observe the restored records. The journal is left as is, and reads of the
+// client are no longer delayed by earlier writes.
+func (c *Client) Restore(snapshot *Snapshot) error {
+	if c.remote != nil {
+		return errSnapshotRemote
+	}
+
+	return c.persisted(true, func() error {
+		return c.restore(snapshot)
+	})
+}
+
+func (c *Client) restore(snapshot *Snapshot) error {
+	txn := c.db.Txn(true)
+	defer txn.Abort()
+
+	from := snapshot.db.Txn(false)
+	defer from.Abort()
+
+	for table := range c.db.DBSchema().Tables {
+		if _, err := txn.DeleteAll(table, "id"); err != nil {
+			return err
+		}
+
+		it, err := from.Get(table, "id")
+		if err != nil {
+			return err
+		}
+
+		for obj := it.Next(); obj != nil; obj = it.Next() {
+			if err := txn.Insert(table, obj); err != nil {
+				return fmt.Errorf("Error restoring %s: %s", table, err)
+			}
+		}
+	}
+
+	txn.Commit()
+
+	c.consistency.reset()
+
+	return nil
+}
@@ -0,0 +1,189 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package backend
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestClient_Restore(t *testing.T) {
+	t.Parallel()
+
+	c := newFreshClient(t)
+
+	user := &User{Email: "ford@prefect.co", Name: "Ford Prefect", Age: 200}
+	if err := c.CreateUser(user); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	snapshot, err := c.Snapshot()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	user.Name = "Ix"
+	if err := c.UpdateUser(user); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := c.CreateUser(&User{Email: "arthur@dent.co", Name: "Arthur Dent", Age: 30}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := c.CreateGroup(&Group{Name: "hitchhikers"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := c.Restore(snapshot); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got, err := c.ReadUser("ford@prefect.co")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got == nil || got.Name != "Ford Prefect" || got.Version != 1 {
+		t.Errorf("expected user to be restored, got: %+v", got)
+	}
+
+	got, err = c.ReadUser("arthur@dent.co")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got != nil {
+		t.Errorf("expected user created after the snapshot to be removed, got: %+v", got)
+	}
+
+	group, err := c.ReadGroup("hitchhikers")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if group != nil {
+		t.Errorf("expected group created after the snapshot to be removed, got: %+v", group)
+	}
+
+	regions, err := c.ReadRegions()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(regions) != 3 {
+		t.Errorf("expected 3 regions, got: %d", len(regions))
+	}
+
+	// the snapshot is unaffected by changes made after restoring it
+	if err := c.CreateUser(&User{Email: "arthur@dent.co", Name: "Arthur Dent", Age: 30}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := c.Restore(snapshot); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got, err = c.ReadUser("arthur@dent.co")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got != nil {
+		t.Errorf("expected snapshot to be restorable twice, got: %+v", got)
+	}
+}
+
+func TestClient_Restore_namespace(t *testing.T) {
+	t.Parallel()
+
+	namespace := t.Name()
+	t.Cleanup(func() { _ = DropNamespace(namespace) })
+
+	a, err := NewClient(WithNamespace(namespace))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	b, err := NewClient(WithNamespace(namespace), WithReadAfterWriteDelay(time.Hour))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	snapshot, err := a.Snapshot()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	user := &User{Email: "ford@prefect.co", Name: "Ford Prefect", Age: 200}
+	if err := b.CreateUser(user); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := b.Restore(snapshot); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := a.CreateUser(user); err != nil {
+		t.Fatalf("expected user to be removed for every client in the namespace, got: %s", err)
+	}
+
+	got, err := b.ReadUser("ford@prefect.co")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got == nil {
+		t.Error("expected reads not to be delayed by writes before the restore")
+	}
+}
+
+func TestClient_Restore_persistence(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "backend.json")
+
+	c, err := NewClient(WithPersistence(path))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	snapshot, err := c.Snapshot()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := c.CreateUser(&User{Email: "ford@prefect.co", Name: "Ford Prefect", Age: 200}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := c.Restore(snapshot); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	other, err := NewClient(WithPersistence(path))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got, err := other.ReadUser("ford@prefect.co")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got != nil {
+		t.Errorf("expected restore to be saved to %s, got: %+v", path, got)
+	}
+}
+
+func TestClient_Snapshot_remote(t *testing.T) {
+	t.Parallel()
+
+	c := newTestServer(t, nil)
+
+	if _, err := c.Snapshot(); err == nil {
+		t.Error("expected error taking snapshot through remote client, got none")
+	}
+}
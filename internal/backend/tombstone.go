@@ -0,0 +1,191 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package backend
+
+import (
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/hashicorp/go-memdb"
+)
+
+// Tombstone is kept for a user deleted by a client created with
+// WithSoftDelete. Until it expires, the user can be restored with
+// RestoreUser, and no other user can be created with the same email.
+type Tombstone struct {
+	Email string
+
+	// User is the user as it was when it was deleted.
+	User *User
+
+	DeletedAt time.Time
+	ExpiresAt time.Time
+}
+
+// expired returns whether the tombstone has expired at the given time, after
+// which it is purged.
+func (t *Tombstone) expired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
+
+// copy returns a deep copy of the tombstone, as objects in memdb must not be
+// modified in place by callers.
+func (t *Tombstone) copy() *Tombstone {
+	c := *t
+
+	if t.User != nil {
+		user := *t.User
+		user.Tags = maps.Clone(t.User.Tags)
+		c.User = &user
+	}
+
+	return &c
+}
+
+// ReadTombstone returns the tombstone of the deleted user with the given
+// email, or nil if there is none or it has expired.
+func (c *Client) ReadTombstone(email string) (*Tombstone, error) {
+	var tombstone *Tombstone
+
+	err := c.faulted(OperationReadTombstone, func() error {
+		if c.remote != nil {
+			var err error
+			tombstone, err = c.remote.readTombstone(email)
+
+			return err
+		}
+
+		return c.persisted(false, func() error {
+			txn := c.db.Txn(false)
+			defer txn.Abort()
+
+			var err error
+			tombstone, err = liveTombstone(txn, email, false)
+			if err != nil {
+				return err
+			}
+
+			if tombstone != nil {
+				tombstone = tombstone.copy()
+			}
+
+			c.journal.record(OperationReadTombstone, email, nil, clone(tombstone))
+
+			return nil
+		})
+	})
+
+	return tombstone, err
+}
+
+// RestoreUser undeletes the user with the given email from its tombstone,
+// erroring if there is no tombstone or it has expired. The restored user is
+// at the version after the deleted user's.
+func (c *Client) RestoreUser(email string) (*User, error) {
+	var user *User
+
+	err := c.faulted(OperationRestoreUser, func() error {
+		if c.remote != nil {
+			var err error
+			user, err = c.remote.restoreUser(email)
+
+			return err
+		}
+
+		return c.persisted(true, func() error {
+			var err error
+			user, err = c.restoreUser(email)
+
+			return err
+		})
+	})
+
+	return user, err
+}
+
+func (c *Client) restoreUser(email string) (*User, error) {
+	txn := c.db.Txn(true)
+	defer txn.Abort()
+
+	existing, err := txn.First("users", "id", email)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing != nil {
+		return nil, fmt.Errorf("Cannot restore user: user already exists with email %s", email)
+	}
+
+	tombstone, err := liveTombstone(txn, email, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if tombstone == nil {
+		return nil, fmt.Errorf("Cannot restore user with email %s: no deleted user with email in db", email)
+	}
+
+	if err := checkRegion(txn, tombstone.User); err != nil {
+		return nil, fmt.Errorf("Cannot restore user with email %s: %s", email, err)
+	}
+
+	if err := c.checkQuota(txn, "users"); err != nil {
+		return nil, err
+	}
+
+	// copy, as objects in memdb must not be modified in place
+	p := *tombstone.User
+	p.Tags = maps.Clone(tombstone.User.Tags)
+	p.Version++
+
+	if err := txn.Insert("users", &p); err != nil {
+		return nil, err
+	}
+
+	if err := txn.Delete("tombstones", tombstone); err != nil {
+		return nil, err
+	}
+
+	txn.Commit()
+
+	c.consistency.recordWrite(email, nil)
+	c.journal.record(OperationRestoreUser, email, nil, clone(&p))
+
+	restored := p
+	restored.Tags = maps.Clone(p.Tags)
+
+	return &restored, nil
+}
+
+// liveTombstone returns the tombstone for the email, or nil if there is none
+// or it has expired. With purge, an expired tombstone is deleted, which
+// requires txn to be a write transaction.
+func liveTombstone(txn *memdb.Txn, email string, purge bool) (*Tombstone, error) {
+	raw, err := txn.First("tombstones", "id", email)
+	if err != nil {
+		return nil, err
+	}
+
+	if raw == nil {
+		return nil, nil
+	}
+
+	tombstone, ok := raw.(*Tombstone)
+	if !ok {
+		return nil, fmt.Errorf("unexpected type %T while reading tombstone", raw)
+	}
+
+	if !tombstone.expired(time.Now()) {
+		return tombstone, nil
+	}
+
+	if purge {
+		if err := txn.Delete("tombstones", tombstone); err != nil {
+			return nil, err
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,250 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package backend
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestClient_softDelete(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewClient(WithFreshDatabase(), WithSoftDelete(time.Hour))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	user := &User{Email: "ford@prefect.co", Name: "Ford Prefect", Age: 200}
+	if err := c.CreateUser(user); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err := c.RestoreUser("ford@prefect.co"); err == nil || !strings.Contains(err.Error(), "user already exists") {
+		t.Errorf("expected error restoring existing user, got: %v", err)
+	}
+
+	if err := c.DeleteUser(user); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got, err := c.ReadUser("ford@prefect.co")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got != nil {
+		t.Fatalf("expected deleted user not to be read, got: %+v", got)
+	}
+
+	tombstone, err := c.ReadTombstone("ford@prefect.co")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if tombstone == nil || tombstone.User.Name != "Ford Prefect" || tombstone.ExpiresAt.Sub(tombstone.DeletedAt) != time.Hour {
+		t.Fatalf("unexpected tombstone: %+v", tombstone)
+	}
+
+	var tombstoneErr *TombstoneError
+
+	if err := c.CreateUser(&User{Email: "ford@prefect.co"}); !errors.As(err, &tombstoneErr) {
+		t.Fatalf("expected TombstoneError, got: %v", err)
+	}
+
+	if tombstoneErr.Email != "ford@prefect.co" || !tombstoneErr.ExpiresAt.Equal(tombstone.ExpiresAt) {
+		t.Errorf("unexpected tombstone error: %+v", tombstoneErr)
+	}
+
+	restored, err := c.RestoreUser("ford@prefect.co")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if restored.Name != "Ford Prefect" || restored.DateJoined != user.DateJoined || restored.Version != 2 {
+		t.Errorf("unexpected restored user: %+v", restored)
+	}
+
+	tombstone, err = c.ReadTombstone("ford@prefect.co")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if tombstone != nil {
+		t.Errorf("expected tombstone to be removed by restore, got: %+v", tombstone)
+	}
+
+	if entries := c.Journal().Query(JournalQuery{Operation: OperationRestoreUser}); len(entries) != 1 {
+		t.Errorf("expected 1 journal entry, got: %+v", entries)
+	}
+}
+
+func TestClient_softDeleteExpired(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewClient(WithFreshDatabase(), WithSoftDelete(10*time.Millisecond))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	user := &User{Email: "ford@prefect.co", Name: "Ford Prefect", Age: 200}
+	if err := c.CreateUser(user); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := c.DeleteUser(user); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	time.Sleep(20 * time.Millisecond)
+
+	tombstone, err := c.ReadTombstone("ford@prefect.co")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if tombstone != nil {
+		t.Errorf("expected expired tombstone not to be read, got: %+v", tombstone)
+	}
+
+	if _, err := c.RestoreUser("ford@prefect.co"); err == nil || !strings.Contains(err.Error(), "no deleted user") {
+		t.Errorf("expected error restoring expired user, got: %v", err)
+	}
+
+	user = &User{Email: "ford@prefect.co", Name: "Ix", Age: 200}
+	if err := c.CreateUser(user); err != nil {
+		t.Fatalf("expected expired tombstone not to block create, got: %s", err)
+	}
+
+	if user.Version != 1 {
+		t.Errorf("expected version 1, got: %d", user.Version)
+	}
+}
+
+// Tombstones and restored users are copies, so modifying them does not
+// modify the db.
+func TestClient_softDeleteCopies(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewClient(WithFreshDatabase(), WithSoftDelete(time.Hour))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	user := &User{Email: "ford@prefect.co", Name: "Ford Prefect", Age: 200, Tags: map[string]string{"planet": "Betelgeuse"}}
+	if err := c.CreateUser(user); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := c.DeleteUser(user); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	tombstone, err := c.ReadTombstone("ford@prefect.co")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	tombstone.ExpiresAt = time.Time{}
+	tombstone.User.Name = "Ix"
+	tombstone.User.Tags["planet"] = "Earth"
+
+	restored, err := c.RestoreUser("ford@prefect.co")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if restored.Name != "Ford Prefect" || restored.Tags["planet"] != "Betelgeuse" {
+		t.Fatalf("expected restored user not to be modified through its tombstone, got: %+v", restored)
+	}
+
+	restored.Tags["planet"] = "Earth"
+
+	got, err := c.ReadUser("ford@prefect.co")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got.Tags["planet"] != "Betelgeuse" {
+		t.Errorf("expected stored user not to be modified through the restored user, got: %+v", got)
+	}
+}
+
+func TestClient_hardDelete(t *testing.T) {
+	t.Parallel()
+
+	c := newFreshClient(t)
+
+	user := &User{Email: "ford@prefect.co", Name: "Ford Prefect", Age: 200}
+	if err := c.CreateUser(user); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := c.DeleteUser(user); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err := c.RestoreUser("ford@prefect.co"); err == nil {
+		t.Error("expected error restoring user deleted without soft delete, got none")
+	}
+
+	if err := c.CreateUser(&User{Email: "ford@prefect.co"}); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
+
+func TestHTTPClient_softDelete(t *testing.T) {
+	t.Parallel()
+
+	server, err := NewClient(WithFreshDatabase(), WithSoftDelete(time.Hour))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	c := newTestServer(t, server)
+
+	user := &User{Email: "ford@prefect.co", Name: "Ford Prefect", Age: 200}
+	if err := c.CreateUser(user); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	tombstone, err := c.ReadTombstone("ford@prefect.co")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if tombstone != nil {
+		t.Errorf("expected no tombstone, got: %+v", tombstone)
+	}
+
+	if err := c.DeleteUser(user); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	tombstone, err = c.ReadTombstone("ford@prefect.co")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if tombstone == nil || tombstone.User.Version != 1 {
+		t.Errorf("unexpected tombstone: %+v", tombstone)
+	}
+
+	var tombstoneErr *TombstoneError
+
+	if err := c.CreateUser(&User{Email: "ford@prefect.co"}); !errors.As(err, &tombstoneErr) {
+		t.Fatalf("expected TombstoneError, got: %v", err)
+	}
+
+	restored, err := c.RestoreUser("ford@prefect.co")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if restored.Name != "Ford Prefect" || restored.Version != 2 {
+		t.Errorf("unexpected restored user: %+v", restored)
+	}
+}
@@ -1,5 +1,5 @@
 // Copyright IBM Corp. 2020, 2026
 // SPDX-License-Identifier: MPL-2.0
 
-// The cornertesting package implements state checks that assist in corner provider testing.
+// The cornertesting package implements state checks and backend helpers that assist in corner provider testing.
 package cornertesting
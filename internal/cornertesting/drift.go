@@ -0,0 +1,57 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cornertesting
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-corner/internal/backend"
+)
+
+// Drift changes backend records behind Terraform's back.
+type Drift func(*backend.Client) error
+
+// MutateUser returns a Drift that applies mutate to the user with the email.
+func MutateUser(email string, mutate func(*backend.User)) Drift {
+	return func(c *backend.Client) error {
+		return c.MutateUser(email, mutate)
+	}
+}
+
+// DeleteUserOutOfBand returns a Drift that deletes the user with the email.
+func DeleteUserOutOfBand(email string) Drift {
+	return func(c *backend.Client) error {
+		return c.DeleteUserOutOfBand(email)
+	}
+}
+
+// InjectUser returns a Drift that stores the user, replacing any existing
+// user with the same email.
+func InjectUser(user backend.User) Drift {
+	return func(c *backend.Client) error {
+		return c.InjectUser(&user)
+	}
+}
+
+// PreConfigDrift returns a TestStep PreConfig function that applies the drifts to the backend namespace in order,
+// failing the test if any of them errors.
+//
+// NOTE: Use the same namespace in the provider configuration, as test steps of other tests may otherwise change the
+// drifted records.
+func PreConfigDrift(t *testing.T, namespace string, drifts ...Drift) func() {
+	return func() {
+		t.Helper()
+
+		c, err := backend.NewClient(backend.WithNamespace(namespace))
+		if err != nil {
+			t.Fatalf("error creating backend client for namespace %q: %s", namespace, err)
+		}
+
+		for _, drift := range drifts {
+			if err := drift(c); err != nil {
+				t.Fatalf("error drifting backend namespace %q: %s", namespace, err)
+			}
+		}
+	}
+}
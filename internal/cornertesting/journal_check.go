@@ -0,0 +1,31 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cornertesting
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/hashicorp/terraform-provider-corner/internal/backend"
+)
+
+// CheckJournalEntryCount returns a check function that asserts the number of entries in the backend journal of the
+// namespace that match the query.
+//
+// NOTE: The journal is shared by every test step using the namespace, so set `query.Since` or expect entries from
+// earlier steps. It keeps only the last backend.DefaultJournalSize entries, and a query by `Caller` only matches entries
+// recorded after calling RecordCallers on the journal.
+func CheckJournalEntryCount(namespace string, query backend.JournalQuery, expected int) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		entries := backend.NamespaceJournal(namespace).Query(query)
+
+		if len(entries) != expected {
+			return fmt.Errorf("expected %d journal entries matching %+v in namespace %q, got %d: %+v", expected, query, namespace, len(entries), entries)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cornertesting
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-corner/internal/backend"
+)
+
+// IsolateBackend snapshots the backend namespace, and restores the snapshot once the test and its subtests complete,
+// so that records left behind by the test, such as when it fails before destroying them, do not affect later tests.
+//
+// NOTE: Call it before resource.Test or resource.UnitTest. Other tests using the namespace at the same time observe the
+// restore, so do not use it in namespaces shared with parallel tests.
+func IsolateBackend(t *testing.T, namespace string) {
+	t.Helper()
+
+	c, err := backend.NewClient(backend.WithNamespace(namespace))
+	if err != nil {
+		t.Fatalf("error creating backend client for namespace %q: %s", namespace, err)
+	}
+
+	snapshot, err := c.Snapshot()
+	if err != nil {
+		t.Fatalf("error snapshotting backend namespace %q: %s", namespace, err)
+	}
+
+	t.Cleanup(func() {
+		if err := c.Restore(snapshot); err != nil {
+			t.Errorf("error restoring backend namespace %q: %s", namespace, err)
+		}
+	})
+}
@@ -0,0 +1,54 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccFrameworkDataSourceRegions(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: map[string]func() (tfprotov5.ProviderServer, error){
+			"framework": providerserver.NewProtocol5WithError(New()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "framework" {
+  namespace = "framework5-data-source-regions"
+}
+
+resource "framework_region" "test" {
+  name        = "Magrathea"
+  description = "Planet factory"
+}
+
+data "framework_regions" "test" {
+  depends_on = [framework_region.test]
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.framework_regions.test", tfjsonpath.New("names"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.StringExact("EU"),
+						knownvalue.StringExact("Magrathea"),
+						knownvalue.StringExact("UK"),
+						knownvalue.StringExact("USA"),
+					})),
+					statecheck.ExpectKnownValue("data.framework_regions.test", tfjsonpath.New("regions").AtSliceIndex(1), knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"name":        knownvalue.StringExact("Magrathea"),
+						"description": knownvalue.StringExact("Planet factory"),
+					})),
+					statecheck.ExpectKnownValue("data.framework_regions.test", tfjsonpath.New("regions").AtSliceIndex(0).AtMapKey("description"), knownvalue.Null()),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,131 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-corner/internal/backend"
+)
+
+var (
+	_ datasource.DataSource              = &dataSourceUser{}
+	_ datasource.DataSourceWithConfigure = &dataSourceUser{}
+)
+
+func NewUserDataSource() datasource.DataSource {
+	return &dataSourceUser{}
+}
+
+type dataSourceUser struct {
+	client *backend.Client
+}
+
+func (d *dataSourceUser) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (d *dataSourceUser) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"email": schema.StringAttribute{
+				Required: true,
+			},
+			"name": schema.StringAttribute{
+				Computed: true,
+			},
+			"age": schema.NumberAttribute{
+				Computed: true,
+			},
+			"date_joined": schema.StringAttribute{
+				Computed: true,
+			},
+			"language": schema.StringAttribute{
+				Computed: true,
+			},
+			"region": schema.StringAttribute{
+				Computed: true,
+			},
+			"version": schema.Int64Attribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *dataSourceUser) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*backend.Client)
+	if !ok {
+		return
+	}
+
+	d.client = client
+}
+
+type userDataSourceModel struct {
+	Email      string       `tfsdk:"email"`
+	Name       string       `tfsdk:"name"`
+	Age        int          `tfsdk:"age"`
+	DateJoined string       `tfsdk:"date_joined"`
+	Language   string       `tfsdk:"language"`
+	Region     types.String `tfsdk:"region"`
+	Version    int64        `tfsdk:"version"`
+}
+
+func newUserDataSourceModel(u *backend.User) userDataSourceModel {
+	m := userDataSourceModel{
+		Email:      u.Email,
+		Name:       u.Name,
+		Age:        u.Age,
+		DateJoined: u.DateJoined,
+		Language:   u.Language,
+		Region:     types.StringNull(),
+		Version:    int64(u.Version),
+	}
+
+	if u.Region != "" {
+		m.Region = types.StringValue(u.Region)
+	}
+
+	return m
+}
+
+func (d dataSourceUser) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	client := d.client.WithContext(ctx)
+
+	var config userDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var p *backend.User
+	err := retryThrottled(ctx, func() error {
+		var err error
+		p, err = client.ReadUser(config.Email)
+
+		return err
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading user", err.Error())
+		return
+	}
+
+	if p == nil {
+		resp.Diagnostics.AddError("Error reading user", fmt.Sprintf("No user with email %s", config.Email))
+		return
+	}
+
+	diags = resp.State.Set(ctx, newUserDataSourceModel(p))
+	resp.Diagnostics.Append(diags...)
+}
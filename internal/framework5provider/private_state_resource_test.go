@@ -0,0 +1,201 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"github.com/hashicorp/terraform-provider-corner/internal/backend"
+	"github.com/hashicorp/terraform-provider-corner/internal/cornertesting"
+)
+
+// Every operation after create errors if the private state is missing, so
+// each step also checks that Terraform round-tripped it.
+func TestPrivateStateResource(t *testing.T) {
+	namespace := "framework5-private-state"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: map[string]func() (tfprotov5.ProviderServer, error){
+			"framework": providerserver.NewProtocol5WithError(New()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: configPrivateState(namespace, "foo", "Ford Prefect", ""),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("framework_private_state.foo", tfjsonpath.New("operations"), knownvalue.Int64Exact(1)),
+				},
+			},
+			{
+				Config: configPrivateState(namespace, "foo", "Arthur Dent", ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("framework_private_state.foo", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("framework_private_state.foo", tfjsonpath.New("operations"), knownvalue.Int64Exact(2)),
+				},
+			},
+			{
+				// the ETag is refreshed by read, so the update does not conflict
+				PreConfig: cornertesting.PreConfigDrift(t, namespace,
+					cornertesting.MutateUser("ford@prefect.co", func(u *backend.User) {
+						u.Name = "Zaphod Beeblebrox"
+					}),
+				),
+				Config: configPrivateState(namespace, "foo", "Arthur Dent", ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("framework_private_state.foo", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("framework_private_state.foo", tfjsonpath.New("name"), knownvalue.StringExact("Arthur Dent")),
+					statecheck.ExpectKnownValue("framework_private_state.foo", tfjsonpath.New("operations"), knownvalue.Int64Exact(3)),
+				},
+			},
+			{
+				Config: configPrivateState(namespace, "bar", "Trillian", `
+moved {
+  from = framework_private_state.foo
+  to   = framework_private_state.bar
+}
+`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("framework_private_state.bar", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("framework_private_state.bar", tfjsonpath.New("operations"), knownvalue.Int64Exact(4)),
+				},
+			},
+			{
+				ResourceName:            "framework_private_state.bar",
+				ImportState:             true,
+				ImportStateId:           "ford@prefect.co",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"operations"},
+			},
+		},
+	})
+}
+
+func TestPrivateStateResource_importBlock(t *testing.T) {
+	namespace := "framework5-private-state-import-block"
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_5_0),
+		},
+		ProtoV5ProviderFactories: map[string]func() (tfprotov5.ProviderServer, error){
+			"framework": providerserver.NewProtocol5WithError(New()),
+		},
+		Steps: []resource.TestStep{
+			{
+				PreConfig: cornertesting.PreConfigDrift(t, namespace,
+					cornertesting.InjectUser(backend.User{
+						Email: "ford@prefect.co",
+						Name:  "Ford Prefect",
+						Age:   200,
+					}),
+				),
+				Config: configPrivateState(namespace, "foo", "Ford Prefect", `
+import {
+  to = framework_private_state.foo
+  id = "ford@prefect.co"
+}
+`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("framework_private_state.foo", plancheck.ResourceActionNoop),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("framework_private_state.foo", tfjsonpath.New("operations"), knownvalue.Int64Exact(0)),
+				},
+			},
+			{
+				Config: configPrivateState(namespace, "foo", "Arthur Dent", ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("framework_private_state.foo", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("framework_private_state.foo", tfjsonpath.New("operations"), knownvalue.Int64Exact(1)),
+				},
+			},
+		},
+	})
+}
+
+func TestPrivateStateResource_moveFromUser(t *testing.T) {
+	namespace := "framework5-private-state-move"
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV5ProviderFactories: map[string]func() (tfprotov5.ProviderServer, error){
+			"framework": providerserver.NewProtocol5WithError(New()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: configResourceUserVersion(namespace, "Ford Prefect"),
+			},
+			{
+				Config: configPrivateState(namespace, "foo", "Ford Prefect", `
+moved {
+  from = framework_user.foo
+  to   = framework_private_state.foo
+}
+`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("framework_private_state.foo", plancheck.ResourceActionNoop),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("framework_private_state.foo", tfjsonpath.New("operations"), knownvalue.Int64Exact(0)),
+				},
+			},
+			{
+				Config: configPrivateState(namespace, "foo", "Arthur Dent", ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("framework_private_state.foo", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("framework_private_state.foo", tfjsonpath.New("operations"), knownvalue.Int64Exact(1)),
+				},
+			},
+		},
+	})
+}
+
+func configPrivateState(namespace, resourceName, name, extra string) string {
+	return fmt.Sprintf(`
+provider "framework" {
+  namespace = %q
+}
+
+resource "framework_private_state" %q {
+  email = "ford@prefect.co"
+  name  = %q
+  age   = 200
+}
+%s`, namespace, resourceName, name, extra)
+}
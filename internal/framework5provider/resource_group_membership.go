@@ -0,0 +1,151 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-provider-corner/internal/backend"
+)
+
+var (
+	_ resource.Resource                = &resourceGroupMembership{}
+	_ resource.ResourceWithConfigure   = &resourceGroupMembership{}
+	_ resource.ResourceWithImportState = &resourceGroupMembership{}
+)
+
+func NewGroupMembershipResource() resource.Resource {
+	return &resourceGroupMembership{}
+}
+
+type resourceGroupMembership struct {
+	client *backend.Client
+}
+
+func (r *resourceGroupMembership) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_membership"
+}
+
+func (r *resourceGroupMembership) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"group": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"email": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceGroupMembership) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		return
+	}
+
+	r.client = data.client
+}
+
+type groupMembership struct {
+	Group string `tfsdk:"group"`
+	Email string `tfsdk:"email"`
+}
+
+func (r resourceGroupMembership) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan groupMembership
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.CreateMembership(&backend.Membership{
+		Group: plan.Group,
+		Email: plan.Email,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating group membership", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceGroupMembership) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state groupMembership
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	m, err := r.client.ReadMembership(state.Group, state.Email)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading group membership", err.Error())
+		return
+	}
+
+	if m == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+}
+
+// Update is never called, as every attribute requires replacement.
+func (r resourceGroupMembership) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError("Error updating group membership", "group memberships cannot be updated in place")
+}
+
+func (r resourceGroupMembership) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state groupMembership
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteMembership(&backend.Membership{
+		Group: state.Group,
+		Email: state.Email,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting group membership", err.Error())
+		return
+	}
+}
+
+// ImportState imports a membership from an ID of the form group/email.
+func (r resourceGroupMembership) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	groupName, email, ok := strings.Cut(req.ID, "/")
+	if !ok || groupName == "" || email == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected import ID of the form group/email, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group"), groupName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("email"), email)...)
+}
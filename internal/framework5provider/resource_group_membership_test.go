@@ -0,0 +1,87 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFrameworkResourceGroupMembership(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: map[string]func() (tfprotov5.ProviderServer, error){
+			"framework": providerserver.NewProtocol5WithError(New()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: configResourceGroupMembership,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("framework_group_membership.test", "group", "hitchhikers"),
+					resource.TestCheckResourceAttr("framework_group_membership.test", "email", "ford@prefect.co"),
+				),
+			},
+			{
+				ResourceName:                         "framework_group_membership.test",
+				ImportState:                          true,
+				ImportStateId:                        "hitchhikers/ford@prefect.co",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "group",
+			},
+		},
+	})
+}
+
+func TestAccFrameworkResourceGroupMembership_missingUser(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: map[string]func() (tfprotov5.ProviderServer, error){
+			"framework": providerserver.NewProtocol5WithError(New()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      configResourceGroupMembershipMissingUser,
+				ExpectError: regexp.MustCompile(`Cannot create membership: user with email arthur@dent.co not in db`),
+			},
+		},
+	})
+}
+
+const configResourceGroupMembership = `
+provider "framework" {
+  namespace = "framework5-group-membership"
+}
+
+resource "framework_user" "test" {
+  email = "ford@prefect.co"
+  name  = "Ford Prefect"
+  age   = 200
+}
+
+resource "framework_group" "test" {
+  name = "hitchhikers"
+}
+
+resource "framework_group_membership" "test" {
+  group = framework_group.test.name
+  email = framework_user.test.email
+}
+`
+
+const configResourceGroupMembershipMissingUser = `
+provider "framework" {
+  namespace = "framework5-group-membership-missing-user"
+}
+
+resource "framework_group" "test" {
+  name = "hitchhikers"
+}
+
+resource "framework_group_membership" "test" {
+  group = framework_group.test.name
+  email = "arthur@dent.co"
+}
+`
@@ -0,0 +1,127 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-corner/internal/backend"
+)
+
+func TestAccFrameworkResourceGroup(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: map[string]func() (tfprotov5.ProviderServer, error){
+			"framework": providerserver.NewProtocol5WithError(New()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: configResourceGroup("framework5-group", "Hitchhikers"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("framework_group.test", "name", "hitchhikers"),
+					resource.TestCheckResourceAttr("framework_group.test", "description", "Hitchhikers"),
+				),
+			},
+			{
+				Config: configResourceGroup("framework5-group", "Guide users"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("framework_group.test", "description", "Guide users"),
+				),
+			},
+			{
+				ResourceName:                         "framework_group.test",
+				ImportState:                          true,
+				ImportStateId:                        "hitchhikers",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+		},
+	})
+}
+
+func TestAccFrameworkResourceGroup_inUse(t *testing.T) {
+	namespace := "framework5-group-in-use"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: map[string]func() (tfprotov5.ProviderServer, error){
+			"framework": providerserver.NewProtocol5WithError(New()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: configResourceGroupInUse(namespace, true),
+			},
+			{
+				PreConfig: func() {
+					client, err := backend.NewClient(backend.WithNamespace(namespace))
+					if err != nil {
+						t.Fatalf("unexpected error: %s", err)
+					}
+
+					err = client.CreateMembership(&backend.Membership{Group: "hitchhikers", Email: "ford@prefect.co"})
+					if err != nil {
+						t.Fatalf("unexpected error: %s", err)
+					}
+				},
+				Config:      configResourceGroupInUse(namespace, false),
+				ExpectError: regexp.MustCompile(`Cannot delete group hitchhikers: still referenced by group membership`),
+			},
+			{
+				PreConfig: func() {
+					client, err := backend.NewClient(backend.WithNamespace(namespace))
+					if err != nil {
+						t.Fatalf("unexpected error: %s", err)
+					}
+
+					err = client.DeleteMembership(&backend.Membership{Group: "hitchhikers", Email: "ford@prefect.co"})
+					if err != nil {
+						t.Fatalf("unexpected error: %s", err)
+					}
+				},
+				Config: configResourceGroupInUse(namespace, false),
+			},
+		},
+	})
+}
+
+func configResourceGroup(namespace, description string) string {
+	return fmt.Sprintf(`
+provider "framework" {
+  namespace = %q
+}
+
+resource "framework_group" "test" {
+  name        = "hitchhikers"
+  description = %q
+}
+`, namespace, description)
+}
+
+func configResourceGroupInUse(namespace string, withGroup bool) string {
+	config := fmt.Sprintf(`
+provider "framework" {
+  namespace = %q
+}
+
+resource "framework_user" "test" {
+  email = "ford@prefect.co"
+  name  = "Ford Prefect"
+  age   = 200
+}
+`, namespace)
+
+	if withGroup {
+		config += `
+resource "framework_group" "test" {
+  name = "hitchhikers"
+}
+`
+	}
+
+	return config
+}
@@ -0,0 +1,135 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-corner/internal/backend"
+)
+
+func TestAccFrameworkResourceRegion(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: map[string]func() (tfprotov5.ProviderServer, error){
+			"framework": providerserver.NewProtocol5WithError(New()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: configResourceRegion("framework5-region", "Planet factory", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("framework_region.test", "name", "Magrathea"),
+					resource.TestCheckResourceAttr("framework_region.test", "description", "Planet factory"),
+					resource.TestCheckResourceAttr("framework_user.test", "region", "Magrathea"),
+				),
+			},
+			{
+				Config: configResourceRegion("framework5-region", "Closed", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("framework_region.test", "description", "Closed"),
+					resource.TestCheckResourceAttr("framework_user.test", "version", "1"),
+				),
+			},
+			{
+				ResourceName:                         "framework_region.test",
+				ImportState:                          true,
+				ImportStateId:                        "Magrathea",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+			{
+				// the user is moved out of the region before it is deleted
+				Config: configResourceRegion("framework5-region", "Closed", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("framework_user.test", "region", "UK"),
+					resource.TestCheckResourceAttr("framework_user.test", "version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFrameworkResourceRegion_inUse(t *testing.T) {
+	namespace := "framework5-region-in-use"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: map[string]func() (tfprotov5.ProviderServer, error){
+			"framework": providerserver.NewProtocol5WithError(New()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: configResourceRegion(namespace, "Planet factory", true),
+			},
+			{
+				PreConfig: func() {
+					client, err := backend.NewClient(backend.WithNamespace(namespace))
+					if err != nil {
+						t.Fatalf("unexpected error: %s", err)
+					}
+
+					err = client.CreateUser(&backend.User{Email: "arthur@dent.co", Name: "Arthur Dent", Age: 30, Region: "Magrathea"})
+					if err != nil {
+						t.Fatalf("unexpected error: %s", err)
+					}
+				},
+				Config:      configResourceRegion(namespace, "Planet factory", false),
+				ExpectError: regexp.MustCompile(`Cannot delete region Magrathea: still referenced by user`),
+			},
+			{
+				PreConfig: func() {
+					client, err := backend.NewClient(backend.WithNamespace(namespace))
+					if err != nil {
+						t.Fatalf("unexpected error: %s", err)
+					}
+
+					err = client.DeleteUser(&backend.User{Email: "arthur@dent.co"})
+					if err != nil {
+						t.Fatalf("unexpected error: %s", err)
+					}
+				},
+				Config: configResourceRegion(namespace, "Planet factory", false),
+			},
+		},
+	})
+}
+
+func configResourceRegion(namespace, description string, withRegion bool) string {
+	if !withRegion {
+		return fmt.Sprintf(`
+provider "framework" {
+  namespace = %q
+}
+
+resource "framework_user" "test" {
+  email  = "ford@prefect.co"
+  name   = "Ford Prefect"
+  age    = 200
+  region = "UK"
+}
+`, namespace)
+	}
+
+	return fmt.Sprintf(`
+provider "framework" {
+  namespace = %q
+}
+
+resource "framework_region" "test" {
+  name        = "Magrathea"
+  description = %q
+}
+
+resource "framework_user" "test" {
+  email  = "ford@prefect.co"
+  name   = "Ford Prefect"
+  age    = 200
+  region = framework_region.test.name
+}
+`, namespace, description)
+}
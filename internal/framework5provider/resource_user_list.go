@@ -0,0 +1,172 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-corner/internal/backend"
+)
+
+var (
+	_ list.ListResource              = &listResourceUser{}
+	_ list.ListResourceWithConfigure = &listResourceUser{}
+)
+
+func NewUserListResource() list.ListResource {
+	return &listResourceUser{}
+}
+
+// listResourceUser lists the users of the backend for the framework_user
+// managed resource, paging through the backend until it runs out of users
+// or reaches the limit of the list block.
+type listResourceUser struct {
+	client *backend.Client
+}
+
+func (r *listResourceUser) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (r *listResourceUser) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"min_age": listschema.Int64Attribute{
+				Optional: true,
+			},
+			"max_age": listschema.Int64Attribute{
+				Optional: true,
+			},
+			"language": listschema.StringAttribute{
+				Optional: true,
+			},
+			"email_prefix": listschema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (r *listResourceUser) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*backend.Client)
+	if !ok {
+		return
+	}
+
+	r.client = client
+}
+
+type userListConfig struct {
+	MinAge      types.Int64  `tfsdk:"min_age"`
+	MaxAge      types.Int64  `tfsdk:"max_age"`
+	Language    types.String `tfsdk:"language"`
+	EmailPrefix types.String `tfsdk:"email_prefix"`
+}
+
+func (r *listResourceUser) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	client := r.client.WithContext(ctx)
+
+	var config userListConfig
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	listReq := backend.ListUsersRequest{
+		Language:    config.Language.ValueString(),
+		EmailPrefix: config.EmailPrefix.ValueString(),
+	}
+	if !config.MinAge.IsNull() {
+		minAge := int(config.MinAge.ValueInt64())
+		listReq.MinAge = &minAge
+	}
+	if !config.MaxAge.IsNull() {
+		maxAge := int(config.MaxAge.ValueInt64())
+		listReq.MaxAge = &maxAge
+	}
+	if req.Limit > 0 {
+		listReq.PageSize = int(min(req.Limit, backend.MaxPageSize))
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+
+		for {
+			var page *backend.ListUsersPage
+			err := retryThrottled(ctx, func() error {
+				var err error
+				page, err = client.ListUsers(listReq)
+
+				return err
+			})
+			if err != nil {
+				var diags diag.Diagnostics
+				diags.AddError("Error listing users", err.Error())
+				push(list.ListResult{Diagnostics: diags})
+
+				return
+			}
+
+			for _, u := range page.Users {
+				if req.Limit > 0 && count >= req.Limit {
+					return
+				}
+				count++
+
+				if !push(newUserListResult(ctx, req, u)) {
+					return
+				}
+			}
+
+			if page.NextPageToken == "" {
+				return
+			}
+
+			listReq.PageToken = page.NextPageToken
+		}
+	}
+}
+
+func newUserListResult(ctx context.Context, req list.ListRequest, u *backend.User) list.ListResult {
+	result := req.NewListResult(ctx)
+	result.DisplayName = u.Name
+
+	result.Diagnostics.Append(result.Identity.Set(ctx, userIdentity{Email: u.Email})...)
+
+	if req.IncludeResource {
+		state := user{
+			Email:           u.Email,
+			Name:            u.Name,
+			Age:             u.Age,
+			DateJoined:      types.StringValue(u.DateJoined),
+			Language:        types.StringValue(u.Language),
+			Region:          types.StringNull(),
+			Version:         types.Int64Value(int64(u.Version)),
+			RestoreOnCreate: types.BoolNull(),
+			Tags:            types.MapNull(types.StringType),
+			TagsAll:         userTagsValue(u.Tags),
+		}
+		if u.Region != "" {
+			state.Region = types.StringValue(u.Region)
+		}
+
+		result.Diagnostics.Append(result.Resource.Set(ctx, state)...)
+	}
+
+	if result.Diagnostics.HasError() {
+		return list.ListResult{Diagnostics: result.Diagnostics}
+	}
+
+	return result
+}
@@ -0,0 +1,130 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccFrameworkUserListResource(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV5ProviderFactories: map[string]func() (tfprotov5.ProviderServer, error){
+			"framework": providerserver.NewProtocol5WithError(New()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: configUserListResourceUsers,
+			},
+			{
+				Query: true,
+				Config: `
+provider "framework" {
+  namespace = "framework5-user-list"
+}
+
+list "framework_user" "all" {
+  provider = framework
+}
+
+list "framework_user" "adults" {
+  provider = framework
+
+  config {
+    min_age  = 30
+    language = "en"
+  }
+}
+
+list "framework_user" "prefix" {
+  provider         = framework
+  include_resource = true
+
+  config {
+    email_prefix = "ford"
+  }
+}
+
+list "framework_user" "limited" {
+  provider = framework
+  limit    = 2
+}
+`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("framework_user.all", 3),
+					querycheck.ExpectLength("framework_user.adults", 2),
+					querycheck.ExpectIdentity("framework_user.adults", map[string]knownvalue.Check{
+						"email": knownvalue.StringExact("arthur@dent.co"),
+					}),
+					querycheck.ExpectIdentity("framework_user.adults", map[string]knownvalue.Check{
+						"email": knownvalue.StringExact("ford@prefect.co"),
+					}),
+					querycheck.ExpectLength("framework_user.prefix", 1),
+					querycheck.ExpectResourceDisplayName("framework_user.prefix", queryfilter.ByResourceIdentity(map[string]knownvalue.Check{
+						"email": knownvalue.StringExact("ford@prefect.co"),
+					}), knownvalue.StringExact("Ford Prefect")),
+					querycheck.ExpectResourceKnownValues("framework_user.prefix", queryfilter.ByResourceIdentity(map[string]knownvalue.Check{
+						"email": knownvalue.StringExact("ford@prefect.co"),
+					}), []querycheck.KnownValueCheck{
+						{
+							Path:       tfjsonpath.New("name"),
+							KnownValue: knownvalue.StringExact("Ford Prefect"),
+						},
+						{
+							Path:       tfjsonpath.New("age"),
+							KnownValue: knownvalue.NumberExact(big.NewFloat(200)),
+						},
+						{
+							Path:       tfjsonpath.New("region"),
+							KnownValue: knownvalue.StringExact("UK"),
+						},
+						{
+							Path:       tfjsonpath.New("version"),
+							KnownValue: knownvalue.Int64Exact(1),
+						},
+					}),
+					querycheck.ExpectLength("framework_user.limited", 2),
+				},
+			},
+		},
+	})
+}
+
+const configUserListResourceUsers = `
+provider "framework" {
+  namespace = "framework5-user-list"
+}
+
+resource "framework_user" "ford" {
+  email  = "ford@prefect.co"
+  name   = "Ford Prefect"
+  age    = 200
+  region = "UK"
+}
+
+resource "framework_user" "arthur" {
+  email = "arthur@dent.co"
+  name  = "Arthur Dent"
+  age   = 30
+}
+
+resource "framework_user" "trillian" {
+  email    = "trillian@astra.co"
+  name     = "Trillian"
+  age      = 29
+  language = "de"
+}
+`
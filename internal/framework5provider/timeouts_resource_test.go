@@ -4,6 +4,7 @@
 package framework
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
@@ -46,3 +47,69 @@ func TestTimeoutsResource_configured(t *testing.T) {
 		},
 	})
 }
+
+func TestTimeoutsResource_update(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: map[string]func() (tfprotov5.ProviderServer, error){
+			"framework": providerserver.NewProtocol5WithError(New()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "framework_timeouts" "test" {
+					duration = "100ms"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("framework_timeouts.test", "id", regexp.MustCompile(`^op-`)),
+				),
+			},
+			{
+				Config: `resource "framework_timeouts" "test" {
+					duration = "200ms"
+
+					timeouts {
+						update = "10s"
+					}
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("framework_timeouts.test", "duration", "200ms"),
+				),
+			},
+		},
+	})
+}
+
+func TestTimeoutsResource_createTimeout(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: map[string]func() (tfprotov5.ProviderServer, error){
+			"framework": providerserver.NewProtocol5WithError(New()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "framework_timeouts" "test" {
+					duration = "1m"
+
+					timeouts {
+						create = "1s"
+					}
+				}`,
+				ExpectError: regexp.MustCompile(`Timed out waiting for operation op-[0-9a-f]+, which is still running: context deadline exceeded`),
+			},
+		},
+	})
+}
+
+func TestTimeoutsResource_failed(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: map[string]func() (tfprotov5.ProviderServer, error){
+			"framework": providerserver.NewProtocol5WithError(New()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "framework_timeouts" "test" {
+					error = "quota exceeded"
+				}`,
+				ExpectError: regexp.MustCompile(`Operation op-[0-9a-f]+ failed: quota exceeded`),
+			},
+		},
+	})
+}
@@ -0,0 +1,114 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestUserIdentityUpgradeResource(t *testing.T) {
+	config := configUserIdentityUpgrade("framework5-user-identity-upgrade")
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				ProtoV5ProviderFactories: map[string]func() (tfprotov5.ProviderServer, error){
+					"framework": providerserver.NewProtocol5WithError(NewWithIdentityUpgradeVersion(0)),
+				},
+				Config: config,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity("framework_user_identity_upgrade.foo", map[string]knownvalue.Check{
+						"email": knownvalue.StringExact("ford@prefect.co"),
+					}),
+				},
+			},
+			{
+				ProtoV5ProviderFactories: map[string]func() (tfprotov5.ProviderServer, error){
+					"framework": providerserver.NewProtocol5WithError(NewWithIdentityUpgradeVersion(1)),
+				},
+				Config: config,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity("framework_user_identity_upgrade.foo", map[string]knownvalue.Check{
+						"local_part": knownvalue.StringExact("ford"),
+						"domain":     knownvalue.StringExact("prefect.co"),
+					}),
+				},
+			},
+			{
+				ProtoV5ProviderFactories: map[string]func() (tfprotov5.ProviderServer, error){
+					"framework": providerserver.NewProtocol5WithError(NewWithIdentityUpgradeVersion(2)),
+				},
+				Config: config,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity("framework_user_identity_upgrade.foo", map[string]knownvalue.Check{
+						"local_part": knownvalue.StringExact("ford"),
+						"domain_labels": knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.StringExact("prefect"),
+							knownvalue.StringExact("co"),
+						}),
+					}),
+				},
+			},
+		},
+	})
+}
+
+func TestUserIdentityUpgradeResource_skipVersion(t *testing.T) {
+	config := configUserIdentityUpgrade("framework5-user-identity-upgrade-skip-version")
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				ProtoV5ProviderFactories: map[string]func() (tfprotov5.ProviderServer, error){
+					"framework": providerserver.NewProtocol5WithError(NewWithIdentityUpgradeVersion(0)),
+				},
+				Config: config,
+			},
+			{
+				// version 0 is upgraded directly to version 2
+				ProtoV5ProviderFactories: map[string]func() (tfprotov5.ProviderServer, error){
+					"framework": providerserver.NewProtocol5WithError(NewWithIdentityUpgradeVersion(2)),
+				},
+				Config: config,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity("framework_user_identity_upgrade.foo", map[string]knownvalue.Check{
+						"local_part": knownvalue.StringExact("ford"),
+						"domain_labels": knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.StringExact("prefect"),
+							knownvalue.StringExact("co"),
+						}),
+					}),
+				},
+			},
+		},
+	})
+}
+
+func configUserIdentityUpgrade(namespace string) string {
+	return fmt.Sprintf(`
+provider "framework" {
+  namespace = %q
+}
+
+resource "framework_user_identity_upgrade" "foo" {
+  email = "ford@prefect.co"
+  name  = "Ford Prefect"
+  age   = 200
+}
+`, namespace)
+}
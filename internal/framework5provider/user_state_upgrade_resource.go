@@ -0,0 +1,401 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-corner/internal/backend"
+)
+
+var _ resource.Resource = &UserStateUpgradeResource{}
+var _ resource.ResourceWithConfigure = &UserStateUpgradeResource{}
+var _ resource.ResourceWithUpgradeState = &UserStateUpgradeResource{}
+
+func NewUserStateUpgradeResource(version int64) resource.Resource {
+	return &UserStateUpgradeResource{
+		version: version,
+	}
+}
+
+// UserStateUpgradeResource is a user whose state is reshaped by each schema
+// version:
+//
+//   - 0: the age is a number, and the language is stored as configured
+//   - 1: the age is an int64, and the language is a lowercase ISO 639-1 code
+//   - 2: the name is split into a first name and a last name
+type UserStateUpgradeResource struct {
+	client *backend.Client
+
+	// version is the schema version, allowing the calling code to simulate a
+	// state upgrade with a single resource implementation.
+	version int64
+}
+
+type userStateUpgradeModelV0 struct {
+	Email    string       `tfsdk:"email"`
+	Name     string       `tfsdk:"name"`
+	Age      types.Number `tfsdk:"age"`
+	Language types.String `tfsdk:"language"`
+}
+
+type userStateUpgradeModelV1 struct {
+	Email    string       `tfsdk:"email"`
+	Name     string       `tfsdk:"name"`
+	Age      int64        `tfsdk:"age"`
+	Language types.String `tfsdk:"language"`
+}
+
+type userStateUpgradeModelV2 struct {
+	Email     string       `tfsdk:"email"`
+	FirstName string       `tfsdk:"first_name"`
+	LastName  string       `tfsdk:"last_name"`
+	Age       int64        `tfsdk:"age"`
+	Language  types.String `tfsdk:"language"`
+}
+
+func (r *UserStateUpgradeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_state_upgrade"
+}
+
+func (r *UserStateUpgradeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	if r.version > 2 {
+		resp.Diagnostics.AddError("Unknown schema version", fmt.Sprintf("Schema version %d is not supported.", r.version))
+		return
+	}
+
+	resp.Schema = userStateUpgradeSchema(r.version)
+}
+
+func userStateUpgradeSchema(version int64) schema.Schema {
+	email := schema.StringAttribute{
+		Required: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+
+	switch version {
+	case 0:
+		return schema.Schema{
+			Attributes: map[string]schema.Attribute{
+				"email": email,
+				"name": schema.StringAttribute{
+					Required: true,
+				},
+				"age": schema.NumberAttribute{
+					Required: true,
+				},
+				"language": schema.StringAttribute{
+					Optional: true,
+					Computed: true,
+				},
+			},
+		}
+	case 1:
+		return schema.Schema{
+			Version: 1,
+			Attributes: map[string]schema.Attribute{
+				"email": email,
+				"name": schema.StringAttribute{
+					Required: true,
+				},
+				"age": schema.Int64Attribute{
+					Required: true,
+				},
+				"language": userStateUpgradeLanguageAttribute(),
+			},
+		}
+	default:
+		return schema.Schema{
+			Version: 2,
+			Attributes: map[string]schema.Attribute{
+				"email": email,
+				"first_name": schema.StringAttribute{
+					Required: true,
+				},
+				"last_name": schema.StringAttribute{
+					Required: true,
+				},
+				"age": schema.Int64Attribute{
+					Required: true,
+				},
+				"language": userStateUpgradeLanguageAttribute(),
+			},
+		}
+	}
+}
+
+func userStateUpgradeLanguageAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(regexp.MustCompile(`^[a-z]{2}$`), "must be a lowercase ISO 639-1 language code"),
+		},
+	}
+}
+
+// UpgradeState upgrades every prior version directly to the current version.
+// Version 0 is upgraded with its prior schema, and version 1 from its raw
+// JSON.
+func (r *UserStateUpgradeResource) UpgradeState(context.Context) map[int64]resource.StateUpgrader {
+	upgraders := map[int64]resource.StateUpgrader{}
+
+	if r.version > 0 {
+		priorSchema := userStateUpgradeSchema(0)
+
+		upgraders[0] = resource.StateUpgrader{
+			PriorSchema: &priorSchema,
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior userStateUpgradeModelV0
+				resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				age, _ := prior.Age.ValueBigFloat().Int64()
+
+				r.setState(ctx, &backend.User{
+					Email:    prior.Email,
+					Name:     prior.Name,
+					Age:      int(age),
+					Language: prior.Language.ValueString(),
+				}, &resp.State, &resp.Diagnostics)
+			},
+		}
+	}
+
+	if r.version > 1 {
+		upgraders[1] = resource.StateUpgrader{
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior struct {
+					Email    string `json:"email"`
+					Name     string `json:"name"`
+					Age      int    `json:"age"`
+					Language string `json:"language"`
+				}
+
+				if err := json.Unmarshal(req.RawState.JSON, &prior); err != nil {
+					resp.Diagnostics.AddError("Unable to unmarshal prior state", err.Error())
+					return
+				}
+
+				r.setState(ctx, &backend.User{
+					Email:    prior.Email,
+					Name:     prior.Name,
+					Age:      prior.Age,
+					Language: prior.Language,
+				}, &resp.State, &resp.Diagnostics)
+			},
+		}
+	}
+
+	return upgraders
+}
+
+// getUser returns the user described by the plan or state, which is in the
+// shape of the current schema version. The language is left empty if it is
+// unknown.
+func (r *UserStateUpgradeResource) getUser(ctx context.Context, data interface {
+	Get(context.Context, interface{}) diag.Diagnostics
+}, diags *diag.Diagnostics) *backend.User {
+	switch r.version {
+	case 0:
+		var m userStateUpgradeModelV0
+		diags.Append(data.Get(ctx, &m)...)
+
+		age, _ := m.Age.ValueBigFloat().Int64()
+
+		return &backend.User{Email: m.Email, Name: m.Name, Age: int(age), Language: m.Language.ValueString()}
+	case 1:
+		var m userStateUpgradeModelV1
+		diags.Append(data.Get(ctx, &m)...)
+
+		return &backend.User{Email: m.Email, Name: m.Name, Age: int(m.Age), Language: m.Language.ValueString()}
+	default:
+		var m userStateUpgradeModelV2
+		diags.Append(data.Get(ctx, &m)...)
+
+		return &backend.User{Email: m.Email, Name: joinName(m.FirstName, m.LastName), Age: int(m.Age), Language: m.Language.ValueString()}
+	}
+}
+
+// setState sets the state of the user in the shape of the current schema
+// version.
+func (r *UserStateUpgradeResource) setState(ctx context.Context, user *backend.User, state *tfsdk.State, diags *diag.Diagnostics) {
+	switch r.version {
+	case 0:
+		diags.Append(state.Set(ctx, userStateUpgradeModelV0{
+			Email:    user.Email,
+			Name:     user.Name,
+			Age:      types.NumberValue(big.NewFloat(float64(user.Age))),
+			Language: types.StringValue(user.Language),
+		})...)
+	case 1:
+		diags.Append(state.Set(ctx, userStateUpgradeModelV1{
+			Email:    user.Email,
+			Name:     user.Name,
+			Age:      int64(user.Age),
+			Language: types.StringValue(normalizeLanguage(user.Language)),
+		})...)
+	default:
+		firstName, lastName := splitName(user.Name)
+
+		diags.Append(state.Set(ctx, userStateUpgradeModelV2{
+			Email:     user.Email,
+			FirstName: firstName,
+			LastName:  lastName,
+			Age:       int64(user.Age),
+			Language:  types.StringValue(normalizeLanguage(user.Language)),
+		})...)
+	}
+}
+
+// normalizeLanguage returns the lowercase ISO 639-1 code of a language tag,
+// such as en for EN-GB.
+func normalizeLanguage(language string) string {
+	code, _, _ := strings.Cut(language, "-")
+	code, _, _ = strings.Cut(code, "_")
+
+	return strings.ToLower(code)
+}
+
+// splitName splits a name at its first space into a first name and a last
+// name.
+func splitName(name string) (string, string) {
+	firstName, lastName, _ := strings.Cut(name, " ")
+
+	return firstName, lastName
+}
+
+func joinName(firstName, lastName string) string {
+	if lastName == "" {
+		return firstName
+	}
+
+	return firstName + " " + lastName
+}
+
+func (r *UserStateUpgradeResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		return
+	}
+
+	r.client = data.client
+}
+
+func (r *UserStateUpgradeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	client := r.client.WithContext(ctx)
+
+	newUser := r.getUser(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := retryThrottled(ctx, func() error {
+		return client.CreateUser(newUser)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating user", err.Error())
+		return
+	}
+
+	p, err := waitForUser(ctx, client, newUser.Email, newUser.Version)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading user", err.Error())
+		return
+	}
+
+	r.setState(ctx, p, &resp.State, &resp.Diagnostics)
+}
+
+func (r *UserStateUpgradeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	client := r.client.WithContext(ctx)
+
+	state := r.getUser(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var p *backend.User
+	err := retryThrottled(ctx, func() error {
+		var err error
+		p, err = client.ReadUser(state.Email)
+
+		return err
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading user", err.Error())
+		return
+	}
+
+	if p == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	r.setState(ctx, p, &resp.State, &resp.Diagnostics)
+}
+
+func (r *UserStateUpgradeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	client := r.client.WithContext(ctx)
+
+	newUser := r.getUser(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := retryThrottled(ctx, func() error {
+		return client.UpdateUser(newUser)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating user", err.Error())
+		return
+	}
+
+	p, err := waitForUser(ctx, client, newUser.Email, newUser.Version)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading user", err.Error())
+		return
+	}
+
+	r.setState(ctx, p, &resp.State, &resp.Diagnostics)
+}
+
+func (r *UserStateUpgradeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	client := r.client.WithContext(ctx)
+
+	state := r.getUser(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := retryThrottled(ctx, func() error {
+		return client.DeleteUser(&backend.User{Email: state.Email})
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting user", err.Error())
+		return
+	}
+}
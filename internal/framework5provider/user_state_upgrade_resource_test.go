@@ -0,0 +1,149 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestUserStateUpgradeResource(t *testing.T) {
+	namespace := "framework5-user-state-upgrade"
+
+	resource.UnitTest(t, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				ProtoV5ProviderFactories: map[string]func() (tfprotov5.ProviderServer, error){
+					"framework": providerserver.NewProtocol5WithError(NewWithStateUpgradeVersion(0)),
+				},
+				Config: configUserStateUpgradeV0(namespace),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("framework_user_state_upgrade.foo", tfjsonpath.New("age"), knownvalue.NumberExact(big.NewFloat(200))),
+					statecheck.ExpectKnownValue("framework_user_state_upgrade.foo", tfjsonpath.New("language"), knownvalue.StringExact("EN-GB")),
+				},
+			},
+			{
+				ProtoV5ProviderFactories: map[string]func() (tfprotov5.ProviderServer, error){
+					"framework": providerserver.NewProtocol5WithError(NewWithStateUpgradeVersion(1)),
+				},
+				Config: configUserStateUpgradeV1(namespace),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("framework_user_state_upgrade.foo", tfjsonpath.New("name"), knownvalue.StringExact("Ford Prefect")),
+					statecheck.ExpectKnownValue("framework_user_state_upgrade.foo", tfjsonpath.New("age"), knownvalue.Int64Exact(200)),
+					statecheck.ExpectKnownValue("framework_user_state_upgrade.foo", tfjsonpath.New("language"), knownvalue.StringExact("en")),
+				},
+			},
+			{
+				ProtoV5ProviderFactories: map[string]func() (tfprotov5.ProviderServer, error){
+					"framework": providerserver.NewProtocol5WithError(NewWithStateUpgradeVersion(2)),
+				},
+				Config: configUserStateUpgradeV2(namespace),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("framework_user_state_upgrade.foo", tfjsonpath.New("first_name"), knownvalue.StringExact("Ford")),
+					statecheck.ExpectKnownValue("framework_user_state_upgrade.foo", tfjsonpath.New("last_name"), knownvalue.StringExact("Prefect")),
+					statecheck.ExpectKnownValue("framework_user_state_upgrade.foo", tfjsonpath.New("age"), knownvalue.Int64Exact(200)),
+					statecheck.ExpectKnownValue("framework_user_state_upgrade.foo", tfjsonpath.New("language"), knownvalue.StringExact("en")),
+				},
+			},
+		},
+	})
+}
+
+func TestUserStateUpgradeResource_skipVersion(t *testing.T) {
+	namespace := "framework5-user-state-upgrade-skip-version"
+
+	resource.UnitTest(t, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				ProtoV5ProviderFactories: map[string]func() (tfprotov5.ProviderServer, error){
+					"framework": providerserver.NewProtocol5WithError(NewWithStateUpgradeVersion(0)),
+				},
+				Config: configUserStateUpgradeV0(namespace),
+			},
+			{
+				// version 0 is upgraded directly to version 2
+				ProtoV5ProviderFactories: map[string]func() (tfprotov5.ProviderServer, error){
+					"framework": providerserver.NewProtocol5WithError(NewWithStateUpgradeVersion(2)),
+				},
+				Config: configUserStateUpgradeV2(namespace),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("framework_user_state_upgrade.foo", tfjsonpath.New("first_name"), knownvalue.StringExact("Ford")),
+					statecheck.ExpectKnownValue("framework_user_state_upgrade.foo", tfjsonpath.New("last_name"), knownvalue.StringExact("Prefect")),
+					statecheck.ExpectKnownValue("framework_user_state_upgrade.foo", tfjsonpath.New("age"), knownvalue.Int64Exact(200)),
+					statecheck.ExpectKnownValue("framework_user_state_upgrade.foo", tfjsonpath.New("language"), knownvalue.StringExact("en")),
+				},
+			},
+		},
+	})
+}
+
+func configUserStateUpgradeV0(namespace string) string {
+	return fmt.Sprintf(`
+provider "framework" {
+  namespace = %q
+}
+
+resource "framework_user_state_upgrade" "foo" {
+  email    = "ford@prefect.co"
+  name     = "Ford Prefect"
+  age      = 200
+  language = "EN-GB"
+}
+`, namespace)
+}
+
+func configUserStateUpgradeV1(namespace string) string {
+	return fmt.Sprintf(`
+provider "framework" {
+  namespace = %q
+}
+
+resource "framework_user_state_upgrade" "foo" {
+  email    = "ford@prefect.co"
+  name     = "Ford Prefect"
+  age      = 200
+  language = "en"
+}
+`, namespace)
+}
+
+func configUserStateUpgradeV2(namespace string) string {
+	return fmt.Sprintf(`
+provider "framework" {
+  namespace = %q
+}
+
+resource "framework_user_state_upgrade" "foo" {
+  email      = "ford@prefect.co"
+  first_name = "Ford"
+  last_name  = "Prefect"
+  age        = 200
+  language   = "en"
+}
+`, namespace)
+}
@@ -0,0 +1,109 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-corner/internal/backend"
+)
+
+var (
+	_ datasource.DataSource              = &dataSourceRegions{}
+	_ datasource.DataSourceWithConfigure = &dataSourceRegions{}
+)
+
+func NewRegionsDataSource() datasource.DataSource {
+	return &dataSourceRegions{}
+}
+
+type dataSourceRegions struct {
+	client *backend.Client
+}
+
+func (d *dataSourceRegions) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_regions"
+}
+
+func (d *dataSourceRegions) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"names": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"regions": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"description": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *dataSourceRegions) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*backend.Client)
+	if !ok {
+		return
+	}
+
+	d.client = client
+}
+
+type regionsDataSourceModel struct {
+	Names   []string `tfsdk:"names"`
+	Regions []region `tfsdk:"regions"`
+}
+
+func (d dataSourceRegions) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	client := d.client.WithContext(ctx)
+
+	var regions []*backend.Region
+	err := retryThrottled(ctx, func() error {
+		var err error
+		regions, err = client.ReadRegions()
+
+		return err
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading regions", err.Error())
+		return
+	}
+
+	state := regionsDataSourceModel{
+		Names:   []string{},
+		Regions: []region{},
+	}
+
+	for _, r := range regions {
+		reg := region{
+			Name:        r.Name,
+			Description: types.StringNull(),
+		}
+		if r.Description != "" {
+			reg.Description = types.StringValue(r.Description)
+		}
+
+		state.Names = append(state.Names, r.Name)
+		state.Regions = append(state.Regions, reg)
+	}
+
+	diags := resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,101 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccFrameworkDataSourceUser(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"framework": providerserver.NewProtocol6WithError(New()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: configDataSourceUser("Ford Prefect"),
+				// the data source depends on a user that is not created
+				// yet, so it is read during apply
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectUnknownValue("data.framework_user.test", tfjsonpath.New("name")),
+						plancheck.ExpectUnknownValue("data.framework_user.test", tfjsonpath.New("version")),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.framework_user.test", tfjsonpath.New("name"), knownvalue.StringExact("Ford Prefect")),
+					statecheck.ExpectKnownValue("data.framework_user.test", tfjsonpath.New("age"), knownvalue.NumberExact(big.NewFloat(200))),
+					statecheck.ExpectKnownValue("data.framework_user.test", tfjsonpath.New("language"), knownvalue.StringExact("en")),
+					statecheck.ExpectKnownValue("data.framework_user.test", tfjsonpath.New("region"), knownvalue.StringExact("UK")),
+					statecheck.ExpectKnownValue("data.framework_user.test", tfjsonpath.New("version"), knownvalue.Int64Exact(1)),
+					statecheck.CompareValuePairs(
+						"data.framework_user.test", tfjsonpath.New("date_joined"),
+						"framework_user.test", tfjsonpath.New("date_joined"),
+						compare.ValuesSame(),
+					),
+				},
+			},
+			{
+				Config: configDataSourceUser("Ix"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.framework_user.test", tfjsonpath.New("name"), knownvalue.StringExact("Ix")),
+					statecheck.ExpectKnownValue("data.framework_user.test", tfjsonpath.New("version"), knownvalue.Int64Exact(2)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccFrameworkDataSourceUser_notFound(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"framework": providerserver.NewProtocol6WithError(New()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "framework" {
+  namespace = "framework6-data-source-user-not-found"
+}
+
+data "framework_user" "test" {
+  email = "arthur@dent.co"
+}
+`,
+				ExpectError: regexp.MustCompile(`No user with email arthur@dent.co`),
+			},
+		},
+	})
+}
+
+func configDataSourceUser(name string) string {
+	return fmt.Sprintf(`
+provider "framework" {
+  namespace = "framework6-data-source-user"
+}
+
+resource "framework_user" "test" {
+  email  = "ford@prefect.co"
+  name   = %q
+  age    = 200
+  region = "UK"
+}
+
+data "framework_user" "test" {
+  email = framework_user.test.email
+}
+`, name)
+}
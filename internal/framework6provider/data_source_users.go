@@ -0,0 +1,155 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-corner/internal/backend"
+)
+
+var (
+	_ datasource.DataSource              = &dataSourceUsers{}
+	_ datasource.DataSourceWithConfigure = &dataSourceUsers{}
+)
+
+func NewUsersDataSource() datasource.DataSource {
+	return &dataSourceUsers{}
+}
+
+// dataSourceUsers lists the users matching its filters, as a list of
+// objects.
+type dataSourceUsers struct {
+	client *backend.Client
+}
+
+func (d *dataSourceUsers) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+func (d *dataSourceUsers) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"min_age": schema.Int64Attribute{
+				Optional: true,
+			},
+			"max_age": schema.Int64Attribute{
+				Optional: true,
+			},
+			"language": schema.StringAttribute{
+				Optional: true,
+			},
+			"email_prefix": schema.StringAttribute{
+				Optional: true,
+			},
+			"users": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"email": schema.StringAttribute{
+							Computed: true,
+						},
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"age": schema.NumberAttribute{
+							Computed: true,
+						},
+						"date_joined": schema.StringAttribute{
+							Computed: true,
+						},
+						"language": schema.StringAttribute{
+							Computed: true,
+						},
+						"region": schema.StringAttribute{
+							Computed: true,
+						},
+						"version": schema.Int64Attribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *dataSourceUsers) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*backend.Client)
+	if !ok {
+		return
+	}
+
+	d.client = client
+}
+
+type usersDataSourceModel struct {
+	MinAge      types.Int64           `tfsdk:"min_age"`
+	MaxAge      types.Int64           `tfsdk:"max_age"`
+	Language    types.String          `tfsdk:"language"`
+	EmailPrefix types.String          `tfsdk:"email_prefix"`
+	Users       []userDataSourceModel `tfsdk:"users"`
+}
+
+func (d dataSourceUsers) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	client := d.client.WithContext(ctx)
+
+	var config usersDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	listReq := backend.ListUsersRequest{
+		Language:    config.Language.ValueString(),
+		EmailPrefix: config.EmailPrefix.ValueString(),
+	}
+	if !config.MinAge.IsNull() {
+		minAge := int(config.MinAge.ValueInt64())
+		listReq.MinAge = &minAge
+	}
+	if !config.MaxAge.IsNull() {
+		maxAge := int(config.MaxAge.ValueInt64())
+		listReq.MaxAge = &maxAge
+	}
+
+	// an empty list rather than null, so that no matches is distinguishable
+	// from not having read
+	config.Users = []userDataSourceModel{}
+
+	for {
+		var page *backend.ListUsersPage
+		err := retryThrottled(ctx, func() error {
+			var err error
+			page, err = client.ListUsers(listReq)
+
+			return err
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Error listing users", err.Error())
+			return
+		}
+
+		for _, u := range page.Users {
+			config.Users = append(config.Users, newUserDataSourceModel(u))
+		}
+
+		if page.NextPageToken == "" {
+			break
+		}
+
+		listReq.PageToken = page.NextPageToken
+	}
+
+	diags = resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+}
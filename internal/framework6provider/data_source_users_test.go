@@ -0,0 +1,137 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccFrameworkDataSourceUsers(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"framework": providerserver.NewProtocol6WithError(New()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: configDataSourceUsers,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.framework_users.all", tfjsonpath.New("users"), knownvalue.ListSizeExact(3)),
+					statecheck.ExpectKnownValue("data.framework_users.adults", tfjsonpath.New("users"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectPartial(map[string]knownvalue.Check{
+							"email":    knownvalue.StringExact("arthur@dent.co"),
+							"language": knownvalue.StringExact("en"),
+							"region":   knownvalue.Null(),
+						}),
+						knownvalue.ObjectPartial(map[string]knownvalue.Check{
+							"email":    knownvalue.StringExact("ford@prefect.co"),
+							"language": knownvalue.StringExact("en"),
+							"region":   knownvalue.StringExact("UK"),
+						}),
+					})),
+					statecheck.ExpectKnownValue("data.framework_users.german", tfjsonpath.New("users"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectPartial(map[string]knownvalue.Check{
+							"email":   knownvalue.StringExact("trillian@astra.co"),
+							"version": knownvalue.Int64Exact(1),
+						}),
+					})),
+					statecheck.ExpectKnownValue("data.framework_users.none", tfjsonpath.New("users"), knownvalue.ListSizeExact(0)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccFrameworkDataSourceUsers_providerDeferral(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_9_0),
+			tfversion.SkipIfNotAlpha(),
+		},
+		AdditionalCLIOptions: &resource.AdditionalCLIOptions{
+			Plan:  resource.PlanOptions{AllowDeferral: true},
+			Apply: resource.ApplyOptions{AllowDeferral: true},
+		},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"framework": providerserver.NewProtocol6WithError(New()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "framework" {
+  namespace = "framework6-data-source-users-deferral"
+  deferral  = true
+}
+
+data "framework_users" "test" {
+  language = "en"
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectDeferredChange("data.framework_users.test", plancheck.DeferredReasonProviderConfigUnknown),
+					},
+				},
+			},
+		},
+	})
+}
+
+const configDataSourceUsers = `
+provider "framework" {
+  namespace = "framework6-data-source-users"
+}
+
+resource "framework_user" "ford" {
+  email  = "ford@prefect.co"
+  name   = "Ford Prefect"
+  age    = 200
+  region = "UK"
+}
+
+resource "framework_user" "arthur" {
+  email = "arthur@dent.co"
+  name  = "Arthur Dent"
+  age   = 30
+}
+
+resource "framework_user" "trillian" {
+  email    = "trillian@astra.co"
+  name     = "Trillian"
+  age      = 29
+  language = "de"
+}
+
+data "framework_users" "all" {
+  depends_on = [framework_user.ford, framework_user.arthur, framework_user.trillian]
+}
+
+data "framework_users" "adults" {
+  min_age  = 30
+  language = "en"
+
+  depends_on = [framework_user.ford, framework_user.arthur, framework_user.trillian]
+}
+
+data "framework_users" "german" {
+  max_age  = 29
+  language = "de"
+
+  depends_on = [framework_user.ford, framework_user.arthur, framework_user.trillian]
+}
+
+data "framework_users" "none" {
+  email_prefix = "zaphod"
+
+  depends_on = [framework_user.ford, framework_user.arthur, framework_user.trillian]
+}
+`
@@ -0,0 +1,331 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-corner/internal/backend"
+)
+
+var _ resource.Resource = &PrivateStateResource{}
+var _ resource.ResourceWithConfigure = &PrivateStateResource{}
+var _ resource.ResourceWithImportState = &PrivateStateResource{}
+var _ resource.ResourceWithMoveState = &PrivateStateResource{}
+
+// privateStateKey is the private state key of the privateStateData of a
+// PrivateStateResource.
+const privateStateKey = "user"
+
+func NewPrivateStateResource() resource.Resource {
+	return &PrivateStateResource{}
+}
+
+// PrivateStateResource is a user that keeps an operation counter and the ETag
+// of the user in private state. Private state is set by create, import and
+// move, and every other operation errors if Terraform did not send it back.
+type PrivateStateResource struct {
+	client *backend.Client
+}
+
+type PrivateStateResourceModel struct {
+	Email string `tfsdk:"email"`
+	Name  string `tfsdk:"name"`
+	Age   int64  `tfsdk:"age"`
+
+	// Operations is the operation counter of the private state, exposed so
+	// tests can check that it survived each operation.
+	Operations types.Int64 `tfsdk:"operations"`
+}
+
+type privateStateData struct {
+	// Operations is the number of times the user was written by the
+	// resource. It is zero if the user was imported or moved.
+	Operations int64 `json:"operations"`
+
+	// ETag is the version of the user when it was last read or written,
+	// which updates and deletes send as their If-Match.
+	ETag int `json:"etag"`
+}
+
+func (r *PrivateStateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_private_state"
+}
+
+func (r *PrivateStateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"email": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"age": schema.Int64Attribute{
+				Required: true,
+			},
+			"operations": schema.Int64Attribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *PrivateStateResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		return
+	}
+
+	r.client = data.client
+}
+
+// getPrivateState returns the private state of the resource, erroring if
+// Terraform did not send it.
+func getPrivateState(ctx context.Context, private interface {
+	GetKey(context.Context, string) ([]byte, diag.Diagnostics)
+}, diags *diag.Diagnostics) *privateStateData {
+	value, getDiags := private.GetKey(ctx, privateStateKey)
+	diags.Append(getDiags...)
+	if diags.HasError() {
+		return nil
+	}
+
+	if len(value) == 0 {
+		diags.AddError(
+			"Missing private state",
+			fmt.Sprintf("Terraform did not send the %q private state key, which is set when the resource is created, imported or moved. "+
+				"This is a bug in Terraform or terraform-plugin-framework.", privateStateKey),
+		)
+		return nil
+	}
+
+	var data privateStateData
+	if err := json.Unmarshal(value, &data); err != nil {
+		diags.AddError("Invalid private state", fmt.Sprintf("Unable to unmarshal the %q private state key: %s", privateStateKey, err))
+		return nil
+	}
+
+	return &data
+}
+
+// setPrivateState sets the private state of the resource.
+func setPrivateState(ctx context.Context, private interface {
+	SetKey(context.Context, string, []byte) diag.Diagnostics
+}, data privateStateData, diags *diag.Diagnostics) {
+	value, err := json.Marshal(data)
+	if err != nil {
+		diags.AddError("Invalid private state", fmt.Sprintf("Unable to marshal the %q private state key: %s", privateStateKey, err))
+		return
+	}
+
+	diags.Append(private.SetKey(ctx, privateStateKey, value)...)
+}
+
+func (r *PrivateStateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan PrivateStateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newUser := &backend.User{
+		Email: plan.Email,
+		Name:  plan.Name,
+		Age:   int(plan.Age),
+	}
+
+	if err := r.client.CreateUser(newUser); err != nil {
+		resp.Diagnostics.AddError("Error creating user", err.Error())
+		return
+	}
+
+	data := privateStateData{
+		Operations: 1,
+		ETag:       newUser.Version,
+	}
+
+	setPrivateState(ctx, resp.Private, data, &resp.Diagnostics)
+
+	plan.Operations = types.Int64Value(data.Operations)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *PrivateStateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	data := getPrivateState(ctx, req.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state PrivateStateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	p, err := r.client.ReadUser(state.Email)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading user", err.Error())
+		return
+	}
+
+	if p == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.ETag = p.Version
+
+	setPrivateState(ctx, resp.Private, *data, &resp.Diagnostics)
+
+	state.Name = p.Name
+	state.Age = int64(p.Age)
+	state.Operations = types.Int64Value(data.Operations)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *PrivateStateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	data := getPrivateState(ctx, req.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan PrivateStateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newUser := &backend.User{
+		Email: plan.Email,
+		Name:  plan.Name,
+		Age:   int(plan.Age),
+	}
+
+	err := r.client.UpdateUserIfVersion(newUser, data.ETag)
+
+	var conflictErr *backend.ConflictError
+	if errors.As(err, &conflictErr) {
+		resp.Diagnostics.AddError("Error updating user", "The user was modified outside of Terraform since it was last read, refresh and try again: "+err.Error())
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating user", err.Error())
+		return
+	}
+
+	data.Operations++
+	data.ETag = newUser.Version
+
+	setPrivateState(ctx, resp.Private, *data, &resp.Diagnostics)
+
+	plan.Operations = types.Int64Value(data.Operations)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *PrivateStateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	data := getPrivateState(ctx, req.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state PrivateStateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteUserIfVersion(&backend.User{Email: state.Email}, data.ETag)
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting user", err.Error())
+		return
+	}
+}
+
+// ImportState imports a user by its email, setting the ETag of its private
+// state to the current version of the user.
+func (r *PrivateStateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	p, err := r.client.ReadUser(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading user", err.Error())
+		return
+	}
+
+	if p == nil {
+		resp.Diagnostics.AddError("Cannot import non-existent user", fmt.Sprintf("No user exists with email %q.", req.ID))
+		return
+	}
+
+	setPrivateState(ctx, resp.Private, privateStateData{ETag: p.Version}, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("email"), req.ID)...)
+}
+
+// MoveState moves a framework_user to a framework_private_state, setting the
+// ETag of its private state to the version of the user.
+func (r *PrivateStateResource) MoveState(_ context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			SourceSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"email":   schema.StringAttribute{},
+					"name":    schema.StringAttribute{},
+					"age":     schema.Int64Attribute{},
+					"version": schema.Int64Attribute{},
+				},
+			},
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if req.SourceProviderAddress != "registry.terraform.io/hashicorp/framework" || req.SourceTypeName != "framework_user" {
+					return
+				}
+
+				var source struct {
+					Email   string      `tfsdk:"email"`
+					Name    string      `tfsdk:"name"`
+					Age     int64       `tfsdk:"age"`
+					Version types.Int64 `tfsdk:"version"`
+				}
+
+				resp.Diagnostics.Append(req.SourceState.Get(ctx, &source)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				data := privateStateData{
+					ETag: int(source.Version.ValueInt64()),
+				}
+
+				setPrivateState(ctx, resp.TargetPrivate, data, &resp.Diagnostics)
+
+				resp.Diagnostics.Append(resp.TargetState.Set(ctx, PrivateStateResourceModel{
+					Email:      source.Email,
+					Name:       source.Name,
+					Age:        source.Age,
+					Operations: types.Int64Value(data.Operations),
+				})...)
+			},
+		},
+	}
+}
@@ -5,15 +5,20 @@ package framework
 
 import (
 	"context"
+	"fmt"
 	"testing/fstest"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/statestore"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
@@ -24,6 +29,7 @@ var (
 	_ provider.ProviderWithFunctions          = (*testProvider)(nil)
 	_ provider.ProviderWithEphemeralResources = (*testProvider)(nil)
 	_ provider.ProviderWithActions            = (*testProvider)(nil)
+	_ provider.ProviderWithListResources      = (*testProvider)(nil)
 	_ provider.ProviderWithStateStores        = (*testProvider)(nil)
 )
 
@@ -39,6 +45,16 @@ func NewWithEphemeralSpy(spy *EphemeralResourceSpyClient) provider.Provider {
 	}
 }
 
+// NewWithFaultInjector returns a provider whose backend client applies the
+// rules of the given FaultInjector, unless overridden by fault blocks in the
+// provider configuration.
+func NewWithFaultInjector(faults *backend.FaultInjector) provider.Provider {
+	return &testProvider{
+		ephSpyClient: &EphemeralResourceSpyClient{},
+		faults:       faults,
+	}
+}
+
 func NewWithUpgradeVersion(version int64) provider.Provider {
 	return &testProvider{
 		ephSpyClient:   &EphemeralResourceSpyClient{},
@@ -46,6 +62,25 @@ func NewWithUpgradeVersion(version int64) provider.Provider {
 	}
 }
 
+// NewWithIdentityUpgradeVersion returns a provider whose
+// framework_user_identity_upgrade resource has the given identity schema
+// version.
+func NewWithIdentityUpgradeVersion(version int64) provider.Provider {
+	return &testProvider{
+		ephSpyClient:           &EphemeralResourceSpyClient{},
+		identityUpgradeVersion: version,
+	}
+}
+
+// NewWithStateUpgradeVersion returns a provider whose
+// framework_user_state_upgrade resource has the given schema version.
+func NewWithStateUpgradeVersion(version int64) provider.Provider {
+	return &testProvider{
+		ephSpyClient:        &EphemeralResourceSpyClient{},
+		stateUpgradeVersion: version,
+	}
+}
+
 func NewWithStateStoreFS(memFS fstest.MapFS) provider.Provider {
 	return &testProvider{
 		stateStoreMemFS: memFS,
@@ -53,9 +88,12 @@ func NewWithStateStoreFS(memFS fstest.MapFS) provider.Provider {
 }
 
 type testProvider struct {
-	ephSpyClient    *EphemeralResourceSpyClient
-	upgradeVersion  int64
-	stateStoreMemFS fstest.MapFS
+	ephSpyClient           *EphemeralResourceSpyClient
+	upgradeVersion         int64
+	identityUpgradeVersion int64
+	stateUpgradeVersion    int64
+	faults                 *backend.FaultInjector
+	stateStoreMemFS        fstest.MapFS
 }
 
 func (p *testProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -71,6 +109,61 @@ func (p *testProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp
 			"deferral": schema.BoolAttribute{
 				Optional: true,
 			},
+			"namespace": schema.StringAttribute{
+				Optional: true,
+			},
+			"persistence_path": schema.StringAttribute{
+				Optional: true,
+			},
+			"read_after_write_delay": schema.StringAttribute{
+				Optional: true,
+			},
+			"soft_delete_retention": schema.StringAttribute{
+				Optional: true,
+			},
+			"rate_limit": schema.Float64Attribute{
+				Optional: true,
+			},
+			"rate_limit_burst": schema.Int64Attribute{
+				Optional: true,
+			},
+			"quotas": schema.MapAttribute{
+				ElementType: types.Int64Type,
+				Optional:    true,
+			},
+			"endpoint": schema.StringAttribute{
+				Optional: true,
+			},
+			"default_tags": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"fault": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"operation": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(faultOperations()...),
+							},
+						},
+						"error": schema.StringAttribute{
+							Optional: true,
+						},
+						"latency": schema.StringAttribute{
+							Optional: true,
+						},
+						"call": schema.Int64Attribute{
+							Optional: true,
+						},
+						"after_commit": schema.BoolAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -83,7 +176,84 @@ func (p *testProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		return
 	}
 
-	client, err := backend.NewClient()
+	var opts []backend.Option
+	if !config.Namespace.IsNull() {
+		opts = append(opts, backend.WithNamespace(config.Namespace.ValueString()))
+	}
+	if !config.PersistencePath.IsNull() {
+		opts = append(opts, backend.WithPersistence(config.PersistencePath.ValueString()))
+	}
+	if !config.ReadAfterWriteDelay.IsNull() {
+		delay, err := time.ParseDuration(config.ReadAfterWriteDelay.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid read_after_write_delay", err.Error())
+			return
+		}
+
+		opts = append(opts, backend.WithReadAfterWriteDelay(delay))
+	}
+	if !config.SoftDeleteRetention.IsNull() {
+		retention, err := time.ParseDuration(config.SoftDeleteRetention.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid soft_delete_retention", err.Error())
+			return
+		}
+
+		opts = append(opts, backend.WithSoftDelete(retention))
+	}
+	if !config.RateLimit.IsNull() {
+		opts = append(opts, backend.WithRateLimit(config.RateLimit.ValueFloat64(), int(config.RateLimitBurst.ValueInt64())))
+	}
+	if !config.Quotas.IsNull() {
+		var quotas map[string]int64
+		resp.Diagnostics.Append(config.Quotas.ElementsAs(ctx, &quotas, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		for table, limit := range quotas {
+			opts = append(opts, backend.WithQuota(table, int(limit)))
+		}
+	}
+	if !config.Endpoint.IsNull() {
+		opts = append(opts,
+			backend.WithEndpoint(config.Endpoint.ValueString()),
+			backend.WithUserAgent(fmt.Sprintf("Terraform/%s terraform-provider-corner/framework6", req.TerraformVersion)),
+		)
+	}
+
+	faults := p.faults
+	if len(config.Faults) > 0 {
+		rules := make([]backend.FaultRule, 0, len(config.Faults))
+
+		for _, fault := range config.Faults {
+			rule := backend.FaultRule{
+				Operation:   backend.Operation(fault.Operation.ValueString()),
+				Error:       fault.Error.ValueString(),
+				Call:        int(fault.Call.ValueInt64()),
+				AfterCommit: fault.AfterCommit.ValueBool(),
+			}
+
+			if !fault.Latency.IsNull() {
+				latency, err := time.ParseDuration(fault.Latency.ValueString())
+				if err != nil {
+					resp.Diagnostics.AddError("Invalid fault latency", err.Error())
+					return
+				}
+
+				rule.Latency = latency
+			}
+
+			rules = append(rules, rule)
+		}
+
+		faults = backend.NewFaultInjector(rules...)
+	}
+	if faults != nil {
+		opts = append(opts, backend.WithFaultInjector(faults))
+	}
+
+	client, err := backend.NewClient(opts...)
 	if err != nil {
 		resp.Diagnostics.AddError("Error initialising client", err.Error())
 	}
@@ -92,7 +262,13 @@ func (p *testProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 			Reason: provider.DeferredReasonProviderConfigUnknown,
 		}
 	}
-	resp.ResourceData = client
+
+	resp.ResourceData = &providerData{
+		client:      client,
+		defaultTags: config.DefaultTags,
+	}
+	resp.DataSourceData = client
+	resp.ListResourceData = client
 	resp.EphemeralResourceData = p.ephSpyClient
 }
 
@@ -105,6 +281,9 @@ func (p *testProvider) Resources(_ context.Context) []func() resource.Resource {
 		NewTimeoutsResource,
 		NewTimeTypesResource,
 		NewUserResource,
+		NewGroupResource,
+		NewRegionResource,
+		NewGroupMembershipResource,
 		NewFloat32PrecisionResource,
 		NewFloat64PrecisionResource,
 		NewTFSDKReflectionResource,
@@ -121,12 +300,24 @@ func (p *testProvider) Resources(_ context.Context) []func() resource.Resource {
 		func() resource.Resource {
 			return NewWriteOnlyUpgradeResource(p.upgradeVersion)
 		},
+		func() resource.Resource {
+			return NewUserIdentityUpgradeResource(p.identityUpgradeVersion)
+		},
+		func() resource.Resource {
+			return NewUserStateUpgradeResource(p.stateUpgradeVersion)
+		},
+		NewPrivateStateResource,
 		NewIdentityResource,
 	}
 }
 
 func (p *testProvider) DataSources(_ context.Context) []func() datasource.DataSource {
-	return nil
+	return []func() datasource.DataSource{
+		NewUserDataSource,
+		NewUsersDataSource,
+		NewRegionsDataSource,
+		NewSchemaDataSource,
+	}
 }
 
 func (p *testProvider) Functions(ctx context.Context) []func() function.Function {
@@ -156,6 +347,12 @@ func (p *testProvider) EphemeralResources(ctx context.Context) []func() ephemera
 	}
 }
 
+func (p *testProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewUserListResource,
+	}
+}
+
 func (p *testProvider) Actions(ctx context.Context) []func() action.Action {
 	return []func() action.Action{
 		NewModifyFileAction,
@@ -168,7 +365,44 @@ func (p *testProvider) StateStores(ctx context.Context) []func() statestore.Stat
 	}
 }
 
+// providerData is the ResourceData of the provider.
+type providerData struct {
+	client *backend.Client
+
+	// defaultTags are the default_tags of the provider configuration, merged
+	// into the tags of every framework_user.
+	defaultTags types.Map
+}
+
 type providerConfig struct {
-	Dummy    types.String `tfsdk:"dummy"`
-	Deferral types.Bool   `tfsdk:"deferral"`
+	Dummy               types.String          `tfsdk:"dummy"`
+	Deferral            types.Bool            `tfsdk:"deferral"`
+	Namespace           types.String          `tfsdk:"namespace"`
+	PersistencePath     types.String          `tfsdk:"persistence_path"`
+	ReadAfterWriteDelay types.String          `tfsdk:"read_after_write_delay"`
+	SoftDeleteRetention types.String          `tfsdk:"soft_delete_retention"`
+	RateLimit           types.Float64         `tfsdk:"rate_limit"`
+	RateLimitBurst      types.Int64           `tfsdk:"rate_limit_burst"`
+	Quotas              types.Map             `tfsdk:"quotas"`
+	Endpoint            types.String          `tfsdk:"endpoint"`
+	DefaultTags         types.Map             `tfsdk:"default_tags"`
+	Faults              []providerFaultConfig `tfsdk:"fault"`
+}
+
+type providerFaultConfig struct {
+	Operation   types.String `tfsdk:"operation"`
+	Error       types.String `tfsdk:"error"`
+	Latency     types.String `tfsdk:"latency"`
+	Call        types.Int64  `tfsdk:"call"`
+	AfterCommit types.Bool   `tfsdk:"after_commit"`
+}
+
+func faultOperations() []string {
+	var operations []string
+
+	for _, op := range backend.Operations() {
+		operations = append(operations, string(op))
+	}
+
+	return operations
 }
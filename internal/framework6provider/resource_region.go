@@ -0,0 +1,165 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-corner/internal/backend"
+)
+
+var (
+	_ resource.Resource                = &resourceRegion{}
+	_ resource.ResourceWithConfigure   = &resourceRegion{}
+	_ resource.ResourceWithImportState = &resourceRegion{}
+)
+
+func NewRegionResource() resource.Resource {
+	return &resourceRegion{}
+}
+
+type resourceRegion struct {
+	client *backend.Client
+}
+
+func (r *resourceRegion) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_region"
+}
+
+func (r *resourceRegion) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (r *resourceRegion) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		return
+	}
+
+	r.client = data.client
+}
+
+type region struct {
+	Name        string       `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (r resourceRegion) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan region
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.CreateRegion(&backend.Region{
+		Name:        plan.Name,
+		Description: plan.Description.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating region", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceRegion) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state region
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reg, err := r.client.ReadRegion(state.Name)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading region", err.Error())
+		return
+	}
+
+	if reg == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Description = types.StringNull()
+	if reg.Description != "" {
+		state.Description = types.StringValue(reg.Description)
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceRegion) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan region
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.UpdateRegion(&backend.Region{
+		Name:        plan.Name,
+		Description: plan.Description.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating region", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceRegion) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state region
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteRegion(&backend.Region{Name: state.Name})
+
+	var inUseErr *backend.InUseError
+	if errors.As(err, &inUseErr) {
+		resp.Diagnostics.AddError("Error deleting region", "Users are still in the region, which must be moved or removed first: "+err.Error())
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting region", err.Error())
+		return
+	}
+}
+
+func (r resourceRegion) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
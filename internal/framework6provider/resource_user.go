@@ -5,20 +5,31 @@ package framework
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-provider-corner/internal/backend"
 )
 
 var (
 	_ resource.Resource                = &resourceUser{}
 	_ resource.ResourceWithConfigure   = &resourceUser{}
+	_ resource.ResourceWithIdentity    = &resourceUser{}
 	_ resource.ResourceWithImportState = &resourceUser{}
+	_ resource.ResourceWithModifyPlan  = &resourceUser{}
+	_ resource.ResourceWithMoveState   = &resourceUser{}
 )
 
 func NewUserResource() resource.Resource {
@@ -27,6 +38,10 @@ func NewUserResource() resource.Resource {
 
 type resourceUser struct {
 	client *backend.Client
+
+	// defaultTags are the default_tags of the provider, which are merged into
+	// the tags_all of every user.
+	defaultTags types.Map
 }
 
 func (r *resourceUser) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -61,6 +76,33 @@ func (r *resourceUser) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"region": schema.StringAttribute{
+				Optional: true,
+			},
+			"version": schema.Int64Attribute{
+				Computed: true,
+			},
+			"restore_on_create": schema.BoolAttribute{
+				Optional: true,
+			},
+			"tags": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"tags_all": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *resourceUser) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"email": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
 		},
 	}
 }
@@ -70,12 +112,13 @@ func (r *resourceUser) Configure(_ context.Context, req resource.ConfigureReques
 		return
 	}
 
-	client, ok := req.ProviderData.(*backend.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		return
 	}
 
-	r.client = client
+	r.client = data.client
+	r.defaultTags = data.defaultTags
 }
 
 type user struct {
@@ -84,9 +127,22 @@ type user struct {
 	Age        int          `tfsdk:"age"`
 	DateJoined types.String `tfsdk:"date_joined"`
 	Language   types.String `tfsdk:"language"`
+	Region     types.String `tfsdk:"region"`
+	Version    types.Int64  `tfsdk:"version"`
+
+	RestoreOnCreate types.Bool `tfsdk:"restore_on_create"`
+
+	Tags    types.Map `tfsdk:"tags"`
+	TagsAll types.Map `tfsdk:"tags_all"`
+}
+
+type userIdentity struct {
+	Email string `tfsdk:"email"`
 }
 
 func (r resourceUser) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	client := r.client.WithContext(ctx)
+
 	var plan user
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -95,55 +151,103 @@ func (r resourceUser) Create(ctx context.Context, req resource.CreateRequest, re
 	}
 
 	newUser := &backend.User{
-		Email: plan.Email,
-		Name:  plan.Name,
-		Age:   plan.Age,
+		Email:  plan.Email,
+		Name:   plan.Name,
+		Age:    plan.Age,
+		Region: plan.Region.ValueString(),
 	}
 	if !plan.Language.IsUnknown() {
 		newUser.Language = plan.Language.ValueString()
 	}
+	diags = plan.TagsAll.ElementsAs(ctx, &newUser.Tags, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := retryThrottled(ctx, func() error {
+		return client.CreateUser(newUser)
+	})
+
+	var tombstoneErr *backend.TombstoneError
+	if errors.As(err, &tombstoneErr) && plan.RestoreOnCreate.ValueBool() {
+		err = restoreUser(ctx, client, newUser)
+	}
 
-	err := r.client.CreateUser(newUser)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating user", err.Error())
 		return
 	}
 
-	p, err := r.client.ReadUser(newUser.Email)
+	p, err := waitForUser(ctx, client, newUser.Email, newUser.Version)
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading user", err.Error())
 		return
 	}
-
-	if p == nil {
-		resp.Diagnostics.AddError("Error reading user", "could not find user after it was created")
-		return
-	}
 	plan.DateJoined = types.StringValue(p.DateJoined)
 	plan.Language = types.StringValue(p.Language)
+	plan.Version = types.Int64Value(int64(p.Version))
+	plan.TagsAll = userTagsValue(p.Tags)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	diags = resp.Identity.Set(ctx, userIdentity{Email: plan.Email})
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r resourceUser) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	client := r.client.WithContext(ctx)
+
+	// only the email, restore_on_create and tags are read from state, as the
+	// rest of the state of an imported user is null until it is read
 	var state user
-	diags := req.State.Get(ctx, &state)
+	diags := req.State.GetAttribute(ctx, path.Root("email"), &state.Email)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.GetAttribute(ctx, path.Root("restore_on_create"), &state.RestoreOnCreate)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.GetAttribute(ctx, path.Root("tags"), &state.Tags)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	p, err := r.client.ReadUser(state.Email)
+	// the identity is null before it is first set, e.g. for state written
+	// before the identity schema was added
+	if req.Identity != nil && !req.Identity.Raw.IsNull() {
+		var identity userIdentity
+		diags = req.Identity.Get(ctx, &identity)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if identity.Email != state.Email {
+			resp.Diagnostics.AddError(
+				"Unexpected user identity",
+				fmt.Sprintf("The identity of the user has email %s, but its state has email %s.", identity.Email, state.Email),
+			)
+			return
+		}
+	}
+
+	var p *backend.User
+	err := retryThrottled(ctx, func() error {
+		var err error
+		p, err = client.ReadUser(state.Email)
+
+		return err
+	})
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading user", err.Error())
 		return
 	}
 
 	if p == nil {
+		resp.State.RemoveResource(ctx)
 		return
 	}
 
@@ -151,45 +255,93 @@ func (r resourceUser) Read(ctx context.Context, req resource.ReadRequest, resp *
 	state.Age = p.Age
 	state.DateJoined = types.StringValue(p.DateJoined)
 	state.Language = types.StringValue(p.Language)
+	state.Version = types.Int64Value(int64(p.Version))
+	state.TagsAll = userTagsValue(p.Tags)
+
+	state.Region = types.StringNull()
+	if p.Region != "" {
+		state.Region = types.StringValue(p.Region)
+	}
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	diags = resp.Identity.Set(ctx, userIdentity{Email: state.Email})
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r resourceUser) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan user
+	client := r.client.WithContext(ctx)
+
+	var plan, state user
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	newUser := &backend.User{
-		Email: plan.Email,
-		Name:  plan.Name,
-		Age:   plan.Age,
+		Email:  plan.Email,
+		Name:   plan.Name,
+		Age:    plan.Age,
+		Region: plan.Region.ValueString(),
 	}
 	if !plan.Language.IsUnknown() {
 		newUser.Language = plan.Language.ValueString()
 	}
+	diags = plan.TagsAll.ElementsAs(ctx, &newUser.Tags, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := retryThrottled(ctx, func() error {
+		if state.Version.IsNull() {
+			return client.UpdateUser(newUser)
+		}
+
+		return client.UpdateUserIfVersion(newUser, int(state.Version.ValueInt64()))
+	})
+
+	var conflictErr *backend.ConflictError
+	if errors.As(err, &conflictErr) {
+		resp.Diagnostics.AddError("Error updating user", "The user was modified outside of Terraform since it was last read, refresh and try again: "+err.Error())
+		return
+	}
 
-	err := r.client.UpdateUser(newUser)
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating user", err.Error())
 		return
 	}
 
+	// wait for the update to be visible, or the next read would store the
+	// previous version and the update after it would conflict
+	p, err := waitForUser(ctx, client, newUser.Email, newUser.Version)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading user", err.Error())
+		return
+	}
+
+	plan.Version = types.Int64Value(int64(p.Version))
+
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	diags = resp.Identity.Set(ctx, userIdentity{Email: plan.Email})
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r resourceUser) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	client := r.client.WithContext(ctx)
+
 	var state user
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -203,13 +355,365 @@ func (r resourceUser) Delete(ctx context.Context, req resource.DeleteRequest, re
 		Age:   state.Age,
 	}
 
-	err := r.client.DeleteUser(userToDelete)
+	err := retryThrottled(ctx, func() error {
+		if state.Version.IsNull() {
+			return client.DeleteUser(userToDelete)
+		}
+
+		return client.DeleteUserIfVersion(userToDelete, int(state.Version.ValueInt64()))
+	})
+
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting user", err.Error())
 		return
 	}
 }
 
+// ModifyPlan merges the default tags of the provider into tags_all. As every
+// update increments the version, the version is unknown whenever tags_all
+// changes, even if the configuration of the user does not.
+func (r resourceUser) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// the user is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var tags types.Map
+	diags := req.Plan.GetAttribute(ctx, path.Root("tags"), &tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tagsAll := mergeTags(r.defaultTags, tags)
+
+	diags = resp.Plan.SetAttribute(ctx, path.Root("tags_all"), tagsAll)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var priorTagsAll types.Map
+	diags = req.State.GetAttribute(ctx, path.Root("tags_all"), &priorTagsAll)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !tagsAll.Equal(priorTagsAll) {
+		diags = resp.Plan.SetAttribute(ctx, path.Root("version"), types.Int64Unknown())
+		resp.Diagnostics.Append(diags...)
+	}
+}
+
+// mergeTags merges default tags with the tags of a user, which take
+// precedence. The result is unknown if any of the tags are unknown.
+func mergeTags(defaultTags, tags types.Map) types.Map {
+	merged := map[string]attr.Value{}
+
+	for _, m := range []types.Map{defaultTags, tags} {
+		if m.IsUnknown() {
+			return types.MapUnknown(types.StringType)
+		}
+
+		for key, value := range m.Elements() {
+			if value.IsUnknown() {
+				return types.MapUnknown(types.StringType)
+			}
+
+			if !value.IsNull() {
+				merged[key] = value
+			}
+		}
+	}
+
+	return types.MapValueMust(types.StringType, merged)
+}
+
+// userTagsValue returns the tags of a user in the backend, which are empty
+// rather than null if the user has no tags.
+func userTagsValue(tags map[string]string) types.Map {
+	elements := make(map[string]attr.Value, len(tags))
+	for key, value := range tags {
+		elements[key] = types.StringValue(value)
+	}
+
+	return types.MapValueMust(types.StringType, elements)
+}
+
+// ImportState imports a user by its email, given either as the import ID or
+// as the email of its identity.
 func (r resourceUser) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("email"), path.Root("email"), req, resp)
+}
+
+// cornerProviderAddress is the address of the SDKv2 and protocol providers,
+// which are both named corner in tests.
+const cornerProviderAddress = "registry.terraform.io/hashicorp/corner"
+
+// MoveState moves a user from the corner_user or corner_user_identity resource
+// of the SDKv2 provider, or from a corner_writeonly_datacheck or
+// corner_v6_writeonly_datacheck resource of the protocol providers with an
+// email. Attributes missing from the source are set once the moved user is
+// read.
+func (r resourceUser) MoveState(_ context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			SourceSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"email":   schema.StringAttribute{},
+					"name":    schema.StringAttribute{},
+					"age":     schema.Int64Attribute{},
+					"region":  schema.StringAttribute{},
+					"version": schema.Int64Attribute{},
+				},
+			},
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if req.SourceProviderAddress != cornerProviderAddress {
+					return
+				}
+
+				if req.SourceTypeName != "corner_user" && req.SourceTypeName != "corner_user_identity" {
+					return
+				}
+
+				if req.SourceState == nil {
+					resp.Diagnostics.AddError(
+						"Unable to move user",
+						fmt.Sprintf("The state of %s could not be decoded with the expected source schema.", req.SourceTypeName),
+					)
+					return
+				}
+
+				var source struct {
+					Email   string       `tfsdk:"email"`
+					Name    string       `tfsdk:"name"`
+					Age     int          `tfsdk:"age"`
+					Region  types.String `tfsdk:"region"`
+					Version types.Int64  `tfsdk:"version"`
+				}
+				diags := req.SourceState.Get(ctx, &source)
+				resp.Diagnostics.Append(diags...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				// the identity of corner_user_identity must agree with its
+				// state, while corner_user has no identity
+				if req.SourceIdentity != nil {
+					email, err := sourceIdentityEmail(req.SourceIdentity)
+					if err != nil {
+						resp.Diagnostics.AddError("Unable to move user", fmt.Sprintf("Error decoding source identity: %s", err))
+						return
+					}
+
+					if email != source.Email {
+						resp.Diagnostics.AddError(
+							"Unexpected user identity",
+							fmt.Sprintf("The identity of the source user has email %s, but its state has email %s.", email, source.Email),
+						)
+						return
+					}
+				}
+
+				moved := user{
+					Email:   source.Email,
+					Name:    source.Name,
+					Age:     source.Age,
+					Region:  source.Region,
+					Version: source.Version,
+					Tags:    types.MapNull(types.StringType),
+					TagsAll: types.MapNull(types.StringType),
+				}
+
+				// SDKv2 stores an empty string for an unset region
+				if source.Region.ValueString() == "" {
+					moved.Region = types.StringNull()
+				}
+
+				setMovedUser(ctx, moved, resp)
+			},
+		},
+		{
+			SourceSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":    schema.StringAttribute{},
+					"email": schema.StringAttribute{},
+				},
+			},
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if req.SourceProviderAddress != cornerProviderAddress {
+					return
+				}
+
+				if req.SourceTypeName != "corner_writeonly_datacheck" && req.SourceTypeName != "corner_v6_writeonly_datacheck" {
+					return
+				}
+
+				if req.SourceState == nil {
+					resp.Diagnostics.AddError(
+						"Unable to move user",
+						fmt.Sprintf("The state of %s could not be decoded with the expected source schema.", req.SourceTypeName),
+					)
+					return
+				}
+
+				var source struct {
+					ID    types.String `tfsdk:"id"`
+					Email types.String `tfsdk:"email"`
+				}
+				diags := req.SourceState.Get(ctx, &source)
+				resp.Diagnostics.Append(diags...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				// the protocol providers do not manage users, so only the
+				// email of a user created outside of Terraform can be moved
+				if source.Email.ValueString() == "" {
+					resp.Diagnostics.AddError(
+						"Unable to move user",
+						fmt.Sprintf("The %s resource with id %s has no email, which is required to move it to a user.", req.SourceTypeName, source.ID.ValueString()),
+					)
+					return
+				}
+
+				setMovedUser(ctx, user{
+					Email:   source.Email.ValueString(),
+					Tags:    types.MapNull(types.StringType),
+					TagsAll: types.MapNull(types.StringType),
+				}, resp)
+			},
+		},
+	}
+}
+
+// setMovedUser sets the target state and identity of a moved user.
+func setMovedUser(ctx context.Context, moved user, resp *resource.MoveStateResponse) {
+	diags := resp.TargetState.Set(ctx, &moved)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.TargetIdentity.Set(ctx, userIdentity{Email: moved.Email})
+	resp.Diagnostics.Append(diags...)
+}
+
+// sourceIdentityEmail returns the email of the raw identity of a moved user.
+func sourceIdentityEmail(rawIdentity *tfprotov6.RawState) (string, error) {
+	identity, err := rawIdentity.Unmarshal(tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"email": tftypes.String,
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var attributes map[string]tftypes.Value
+	if err := identity.As(&attributes); err != nil {
+		return "", err
+	}
+
+	var email string
+	if err := attributes["email"].As(&email); err != nil {
+		return "", err
+	}
+
+	return email, nil
+}
+
+// restoreUser restores the deleted user with the email of newUser, then
+// updates it to the name, age, language, region and tags of newUser if they
+// differ.
+func restoreUser(ctx context.Context, client *backend.Client, newUser *backend.User) error {
+	var restored *backend.User
+	err := retryThrottled(ctx, func() error {
+		var err error
+		restored, err = client.RestoreUser(newUser.Email)
+
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if restored.Name == newUser.Name && restored.Age == newUser.Age && restored.Region == newUser.Region && maps.Equal(restored.Tags, newUser.Tags) && (newUser.Language == "" || restored.Language == newUser.Language) {
+		return nil
+	}
+
+	return retryThrottled(ctx, func() error {
+		return client.UpdateUserIfVersion(newUser, restored.Version)
+	})
+}
+
+// userThrottledTimeout bounds how long the user resource retries operations
+// that the backend throttled.
+const userThrottledTimeout = time.Minute
+
+// retryThrottled calls fn until it does not fail with a throttling error,
+// waiting for the retry-after hint of the error between calls. It gives up
+// once ctx is done, or would be before the hint elapses.
+func retryThrottled(ctx context.Context, fn func() error) error {
+	ctx, cancel := context.WithTimeout(ctx, userThrottledTimeout)
+	defer cancel()
+
+	for {
+		err := fn()
+
+		after, ok := backend.RetryAfter(err)
+		if !ok {
+			return err
+		}
+
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < after {
+			return fmt.Errorf("giving up retrying, as the deadline is before the retry-after of %s: %w", after, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("giving up retrying: %w", err)
+		case <-time.After(after):
+		}
+	}
+}
+
+// userVisibleTimeout bounds how long Create and Update wait for a write to
+// become visible when the backend is eventually consistent.
+const userVisibleTimeout = time.Minute
+
+// waitForUser reads the user with exponential backoff until it is found at
+// the given version or later, as an eventually consistent backend may return
+// the previous version of the user, or none, right after a write.
+func waitForUser(ctx context.Context, client *backend.Client, email string, version int) (*backend.User, error) {
+	ctx, cancel := context.WithTimeout(ctx, userVisibleTimeout)
+	defer cancel()
+
+	backoff := 10 * time.Millisecond
+
+	for {
+		var p *backend.User
+		err := retryThrottled(ctx, func() error {
+			var err error
+			p, err = client.ReadUser(email)
+
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if p != nil && p.Version >= version {
+			return p, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("could not find version %d of user after it was written: %w", version, ctx.Err())
+		case <-time.After(backoff):
+		}
+
+		backoff = min(backoff*2, time.Second)
+	}
 }
@@ -4,14 +4,32 @@
 package framework
 
 import (
+	"fmt"
+	"net/http/httptest"
+	"path/filepath"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"github.com/hashicorp/terraform-provider-corner/internal/backend"
+	"github.com/hashicorp/terraform-provider-corner/internal/cornertesting"
+	protocolv6 "github.com/hashicorp/terraform-provider-corner/internal/protocolv6provider"
+	sdkv2 "github.com/hashicorp/terraform-provider-corner/internal/sdkv2provider"
 )
 
 func TestAccFrameworkResourceUser(t *testing.T) {
+	cornertesting.IsolateBackend(t, backend.DefaultNamespace)
+
 	resource.UnitTest(t, resource.TestCase{
 		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
 			"framework": providerserver.NewProtocol6WithError(New()),
@@ -28,6 +46,8 @@ func TestAccFrameworkResourceUser(t *testing.T) {
 						"framework_user.foo", "age", "200"),
 					resource.TestCheckResourceAttr(
 						"framework_user.foo", "language", "en"),
+					resource.TestCheckResourceAttr(
+						"framework_user.foo", "version", "1"),
 				),
 			},
 		},
@@ -35,6 +55,8 @@ func TestAccFrameworkResourceUser(t *testing.T) {
 }
 
 func TestAccFrameworkResourceUser_language(t *testing.T) {
+	cornertesting.IsolateBackend(t, backend.DefaultNamespace)
+
 	resource.UnitTest(t, resource.TestCase{
 		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
 			"framework": providerserver.NewProtocol6WithError(New()),
@@ -58,6 +80,8 @@ func TestAccFrameworkResourceUser_language(t *testing.T) {
 }
 
 func TestAccFrameworkResourceUser_interpolateLanguage(t *testing.T) {
+	cornertesting.IsolateBackend(t, backend.DefaultNamespace)
+
 	resource.UnitTest(t, resource.TestCase{
 		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
 			"framework": providerserver.NewProtocol6WithError(New()),
@@ -98,11 +122,778 @@ func TestAccFrameworkResourceUser_interpolateLanguage(t *testing.T) {
 	})
 }
 
+func TestAccFrameworkResourceUser_namespace(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"framework": providerserver.NewProtocol6WithError(New()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: configResourceUserNamespace,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"framework_user.a", "name", "Ford Prefect"),
+					resource.TestCheckResourceAttr(
+						"framework_user.b", "name", "Arthur Dent"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFrameworkResourceUser_persistencePath(t *testing.T) {
+	persistencePath := filepath.Join(t.TempDir(), "backend.json")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"framework": providerserver.NewProtocol6WithError(New()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configResourceUserPersistencePath, persistencePath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"framework_user.foo", "name", "Ford Prefect"),
+					func(_ *terraform.State) error {
+						client, err := backend.NewClient(backend.WithPersistence(persistencePath))
+						if err != nil {
+							return err
+						}
+
+						u, err := client.ReadUser("ford@prefect.co")
+						if err != nil {
+							return err
+						}
+
+						if u == nil {
+							return fmt.Errorf("expected user to be persisted to %s", persistencePath)
+						}
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccFrameworkResourceUser_fault(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"framework": providerserver.NewProtocol6WithError(New()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      configResourceUserFault,
+				ExpectError: regexp.MustCompile(`injected fault in CreateUser \(call 1\): service unavailable`),
+			},
+		},
+	})
+}
+
+func TestAccFrameworkResourceUser_faultInjector(t *testing.T) {
+	faults := backend.NewFaultInjector(backend.FaultRule{
+		Operation: backend.OperationUpdateUser,
+		Error:     "conflict",
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"framework": providerserver.NewProtocol6WithError(NewWithFaultInjector(faults)),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: configResourceUserFaultInjector("Ford Prefect"),
+				Check: resource.TestCheckResourceAttr(
+					"framework_user.foo", "name", "Ford Prefect"),
+			},
+			{
+				Config:      configResourceUserFaultInjector("Arthur Dent"),
+				ExpectError: regexp.MustCompile(`injected fault in UpdateUser \(call 1\): conflict`),
+			},
+		},
+	})
+}
+
+func TestAccFrameworkResourceUser_version(t *testing.T) {
+	namespace := "framework6-user-version"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"framework": providerserver.NewProtocol6WithError(New()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: configResourceUserVersion(namespace, "Ford Prefect"),
+				Check: resource.TestCheckResourceAttr(
+					"framework_user.foo", "version", "1"),
+			},
+			{
+				Config: configResourceUserVersion(namespace, "Arthur Dent"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"framework_user.foo", "name", "Arthur Dent"),
+					resource.TestCheckResourceAttr(
+						"framework_user.foo", "version", "2"),
+				),
+			},
+			{
+				PreConfig: func() {
+					client, err := backend.NewClient(backend.WithNamespace(namespace))
+					if err != nil {
+						t.Fatalf("unexpected error: %s", err)
+					}
+
+					err = client.UpdateUser(&backend.User{Email: "ford@prefect.co", Name: "Zaphod Beeblebrox", Age: 200})
+					if err != nil {
+						t.Fatalf("unexpected error: %s", err)
+					}
+				},
+				Config: configResourceUserVersion(namespace, "Arthur Dent"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"framework_user.foo", "name", "Arthur Dent"),
+					resource.TestCheckResourceAttr(
+						"framework_user.foo", "version", "4"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFrameworkResourceUser_eventualConsistency(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"framework": providerserver.NewProtocol6WithError(New()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: configResourceUserEventualConsistency,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"framework_user.foo", "name", "Ford Prefect"),
+					resource.TestCheckResourceAttrSet(
+						"framework_user.foo", "date_joined"),
+				),
+			},
+		},
+	})
+}
+
+// Every write waits until it is visible, so an update is not made against a
+// stale read of the version before the previous update, which would conflict.
+func TestAccFrameworkResourceUser_eventualConsistencyUpdate(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"framework": providerserver.NewProtocol6WithError(New()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: configResourceUserEventualConsistencyUpdate("Ford Prefect"),
+				Check: resource.TestCheckResourceAttr(
+					"framework_user.foo", "version", "1"),
+			},
+			{
+				Config: configResourceUserEventualConsistencyUpdate("Arthur Dent"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"framework_user.foo", "name", "Arthur Dent"),
+					resource.TestCheckResourceAttr(
+						"framework_user.foo", "version", "2"),
+				),
+			},
+			{
+				Config: configResourceUserEventualConsistencyUpdate("Zaphod Beeblebrox"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"framework_user.foo", "name", "Zaphod Beeblebrox"),
+					resource.TestCheckResourceAttr(
+						"framework_user.foo", "version", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFrameworkResourceUser_journal(t *testing.T) {
+	namespace := "framework6-user-journal"
+
+	backend.NamespaceJournal(namespace).RecordCallers(true)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"framework": providerserver.NewProtocol6WithError(New()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: configResourceUserVersion(namespace, "Ford Prefect"),
+				Check: resource.ComposeTestCheckFunc(
+					cornertesting.CheckJournalEntryCount(namespace, backend.JournalQuery{
+						Operation: backend.OperationCreateUser,
+						Caller:    "resourceUser.Create",
+					}, 1),
+				),
+			},
+			{
+				Config: configResourceUserVersion(namespace, "Arthur Dent"),
+				Check: resource.ComposeTestCheckFunc(
+					// in-place update, without delete and recreate
+					cornertesting.CheckJournalEntryCount(namespace, backend.JournalQuery{
+						Operation: backend.OperationUpdateUser,
+						Key:       "ford@prefect.co",
+						Caller:    "resourceUser.Update",
+					}, 1),
+					cornertesting.CheckJournalEntryCount(namespace, backend.JournalQuery{
+						Operation: backend.OperationDeleteUser,
+					}, 0),
+					cornertesting.CheckJournalEntryCount(namespace, backend.JournalQuery{
+						Operation: backend.OperationCreateUser,
+					}, 1),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFrameworkResourceUser_endpoint(t *testing.T) {
+	server, err := backend.NewClient(backend.WithFreshDatabase())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ts := httptest.NewServer(backend.NewHandler(server))
+	t.Cleanup(ts.Close)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"framework": providerserver.NewProtocol6WithError(New()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: configResourceUserEndpoint(ts.URL, "Ford Prefect"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"framework_user.foo", "version", "1"),
+					func(_ *terraform.State) error {
+						u, err := server.ReadUser("ford@prefect.co")
+						if err != nil {
+							return err
+						}
+
+						if u == nil {
+							return fmt.Errorf("expected user to be created on %s", ts.URL)
+						}
+
+						return nil
+					},
+				),
+			},
+			{
+				Config: configResourceUserEndpoint(ts.URL, "Arthur Dent"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"framework_user.foo", "name", "Arthur Dent"),
+					resource.TestCheckResourceAttr(
+						"framework_user.foo", "version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFrameworkResourceUser_drift(t *testing.T) {
+	namespace := "framework6-user-drift"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"framework": providerserver.NewProtocol6WithError(New()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: configResourceUserDrift(namespace),
+				Check: resource.TestCheckResourceAttr(
+					"framework_user.foo", "version", "1"),
+			},
+			{
+				PreConfig: cornertesting.PreConfigDrift(t, namespace,
+					cornertesting.MutateUser("ford@prefect.co", func(u *backend.User) {
+						u.Name = "Zaphod Beeblebrox"
+						u.Language = "es"
+					}),
+				),
+				Config: configResourceUserDrift(namespace),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("framework_user.foo", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"framework_user.foo", "name", "Ford Prefect"),
+					resource.TestCheckResourceAttr(
+						"framework_user.foo", "language", "en"),
+					resource.TestCheckResourceAttr(
+						"framework_user.foo", "version", "3"),
+				),
+			},
+			{
+				PreConfig: cornertesting.PreConfigDrift(t, namespace,
+					cornertesting.DeleteUserOutOfBand("ford@prefect.co"),
+				),
+				Config: configResourceUserDrift(namespace),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("framework_user.foo", plancheck.ResourceActionCreate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr(
+					"framework_user.foo", "version", "1"),
+			},
+			{
+				// only the computed version drifts, which does not need an update
+				PreConfig: cornertesting.PreConfigDrift(t, namespace,
+					cornertesting.InjectUser(backend.User{
+						Email:    "ford@prefect.co",
+						Name:     "Ford Prefect",
+						Age:      200,
+						Language: "en",
+					}),
+				),
+				Config: configResourceUserDrift(namespace),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("framework_user.foo", plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.TestCheckResourceAttr(
+					"framework_user.foo", "version", "2"),
+			},
+		},
+	})
+}
+
+func TestAccFrameworkResourceUser_defaultTags(t *testing.T) {
+	namespace := "framework6-user-default-tags"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"framework": providerserver.NewProtocol6WithError(New()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: configResourceUserDefaultTags(namespace, "test"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("framework_user.foo", tfjsonpath.New("tags_all"), knownvalue.MapExact(map[string]knownvalue.Check{
+						"environment": knownvalue.StringExact("test"),
+						"owner":       knownvalue.StringExact("ford"),
+						"planet":      knownvalue.StringExact("betelgeuse"),
+					})),
+					statecheck.ExpectKnownValue("framework_user.foo", tfjsonpath.New("version"), knownvalue.Int64Exact(1)),
+				},
+			},
+			{
+				// only the default tags of the provider change
+				Config: configResourceUserDefaultTags(namespace, "production"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("framework_user.foo", plancheck.ResourceActionUpdate),
+						plancheck.ExpectUnknownValue("framework_user.foo", tfjsonpath.New("version")),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("framework_user.foo", tfjsonpath.New("tags"), knownvalue.MapExact(map[string]knownvalue.Check{
+						"owner":  knownvalue.StringExact("ford"),
+						"planet": knownvalue.StringExact("betelgeuse"),
+					})),
+					statecheck.ExpectKnownValue("framework_user.foo", tfjsonpath.New("tags_all"), knownvalue.MapExact(map[string]knownvalue.Check{
+						"environment": knownvalue.StringExact("production"),
+						"owner":       knownvalue.StringExact("ford"),
+						"planet":      knownvalue.StringExact("betelgeuse"),
+					})),
+					statecheck.ExpectKnownValue("framework_user.foo", tfjsonpath.New("version"), knownvalue.Int64Exact(2)),
+				},
+			},
+			{
+				Config: configResourceUserDefaultTags(namespace, "production"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				PreConfig: cornertesting.PreConfigDrift(t, namespace,
+					cornertesting.MutateUser("ford@prefect.co", func(u *backend.User) {
+						u.Tags["environment"] = "test"
+					}),
+				),
+				Config: configResourceUserDefaultTags(namespace, "production"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("framework_user.foo", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("framework_user.foo", tfjsonpath.New("tags_all").AtMapKey("environment"), knownvalue.StringExact("production")),
+					statecheck.ExpectKnownValue("framework_user.foo", tfjsonpath.New("version"), knownvalue.Int64Exact(4)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccFrameworkResourceUser_softDelete(t *testing.T) {
+	namespace := "framework6-user-soft-delete"
+
+	// the tombstone left by the destroy would fail the test when run again
+	cornertesting.IsolateBackend(t, namespace)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"framework": providerserver.NewProtocol6WithError(New()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: configResourceUserSoftDelete(namespace, "Ford Prefect", false),
+				Check: resource.TestCheckResourceAttr(
+					"framework_user.foo", "version", "1"),
+			},
+			{
+				Config: configResourceUserSoftDeleteProvider(namespace),
+				Check: func(_ *terraform.State) error {
+					client, err := backend.NewClient(backend.WithNamespace(namespace))
+					if err != nil {
+						return err
+					}
+
+					tombstone, err := client.ReadTombstone("ford@prefect.co")
+					if err != nil {
+						return err
+					}
+
+					if tombstone == nil {
+						return fmt.Errorf("expected deleted user to be kept as a tombstone")
+					}
+
+					return nil
+				},
+			},
+			{
+				Config:      configResourceUserSoftDelete(namespace, "Ford Prefect", false),
+				ExpectError: regexp.MustCompile(`can be restored until`),
+			},
+			{
+				// restoring the user bumps the version, and updating its name
+				// bumps it again
+				Config: configResourceUserSoftDelete(namespace, "Arthur Dent", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"framework_user.foo", "name", "Arthur Dent"),
+					resource.TestCheckResourceAttr(
+						"framework_user.foo", "version", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFrameworkResourceUser_rateLimit(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"framework": providerserver.NewProtocol6WithError(New()),
+		},
+		Steps: []resource.TestStep{
+			{
+				// most operations are throttled, and retried once allowed
+				Config: configResourceUserRateLimit,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"framework_user.foo.0", "version", "1"),
+					resource.TestCheckResourceAttr(
+						"framework_user.foo.1", "version", "1"),
+					resource.TestCheckResourceAttr(
+						"framework_user.foo.2", "version", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFrameworkResourceUser_quota(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"framework": providerserver.NewProtocol6WithError(New()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: configResourceUserQuota("ford@prefect.co", "Ford Prefect"),
+			},
+			{
+				// the replacement deletes the user before creating the new
+				// one, which frees the quota
+				Config: configResourceUserQuota("arthur@dent.co", "Arthur Dent"),
+				Check: resource.TestCheckResourceAttr(
+					"framework_user.foo", "email", "arthur@dent.co"),
+			},
+			{
+				// a quota is not a rate limit, so the create fails without
+				// being retried
+				Config: configResourceUserQuota("arthur@dent.co", "Arthur Dent") + `
+resource "framework_user" "bar" {
+  email = "zaphod@beeblebrox.co"
+  name  = "Zaphod Beeblebrox"
+  age   = 200
+}
+`,
+				ExpectError: regexp.MustCompile(`Quota exceeded for users`),
+			},
+		},
+	})
+}
+
+func TestAccFrameworkResourceUser_import(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"framework": providerserver.NewProtocol6WithError(New()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: configResourceUserImport,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity("framework_user.foo", map[string]knownvalue.Check{
+						"email": knownvalue.StringExact("ford@prefect.co"),
+					}),
+				},
+			},
+			{
+				ResourceName:                         "framework_user.foo",
+				ImportState:                          true,
+				ImportStateKind:                      resource.ImportCommandWithID,
+				ImportStateId:                        "ford@prefect.co",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "email",
+			},
+			{
+				ResourceName:    "framework_user.foo",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithID,
+				ImportStateId:   "ford@prefect.co",
+			},
+			{
+				ResourceName:    "framework_user.foo",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
+func TestAccFrameworkResourceUser_importBlockIdentity(t *testing.T) {
+	namespace := "framework6-user-import-identity"
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"framework": providerserver.NewProtocol6WithError(New()),
+		},
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					client, err := backend.NewClient(backend.WithNamespace(namespace))
+					if err != nil {
+						t.Fatalf("unexpected error: %s", err)
+					}
+
+					err = client.CreateUser(&backend.User{Email: "arthur@dent.co", Name: "Arthur Dent", Age: 30})
+					if err != nil {
+						t.Fatalf("unexpected error: %s", err)
+					}
+				},
+				Config: fmt.Sprintf(`
+provider "framework" {
+  namespace = %q
+}
+
+import {
+  to = framework_user.foo
+  identity = {
+    email = "arthur@dent.co"
+  }
+}
+
+resource "framework_user" "foo" {
+  email = "arthur@dent.co"
+  name  = "Arthur Dent"
+  age   = 30
+}
+`, namespace),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("framework_user.foo", plancheck.ResourceActionNoop),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("framework_user.foo", tfjsonpath.New("version"), knownvalue.Int64Exact(1)),
+					statecheck.ExpectIdentity("framework_user.foo", map[string]knownvalue.Check{
+						"email": knownvalue.StringExact("arthur@dent.co"),
+					}),
+				},
+			},
+		},
+	})
+}
+
+func TestAccFrameworkResourceUser_moveFromSDKv2(t *testing.T) {
+	namespace := "framework6-user-move-sdkv2"
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV5ProviderFactories: map[string]func() (tfprotov5.ProviderServer, error){
+			"corner": func() (tfprotov5.ProviderServer, error) { //nolint
+				return sdkv2.New().GRPCProvider(), nil
+			},
+		},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"framework": providerserver.NewProtocol6WithError(New()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: configResourceUserMoveFromSDKv2(namespace, "corner_user", false),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("corner_user.old", tfjsonpath.New("version"), knownvalue.Int64Exact(1)),
+				},
+			},
+			{
+				Config: configResourceUserMoveFromSDKv2(namespace, "corner_user", true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("framework_user.new", tfjsonpath.New("name"), knownvalue.StringExact("Ford Prefect")),
+					statecheck.ExpectKnownValue("framework_user.new", tfjsonpath.New("age"), knownvalue.Int64Exact(200)),
+					statecheck.ExpectKnownValue("framework_user.new", tfjsonpath.New("region"), knownvalue.Null()),
+					statecheck.ExpectKnownValue("framework_user.new", tfjsonpath.New("language"), knownvalue.StringExact("en")),
+					statecheck.ExpectKnownValue("framework_user.new", tfjsonpath.New("version"), knownvalue.Int64Exact(1)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccFrameworkResourceUser_moveFromSDKv2Identity(t *testing.T) {
+	namespace := "framework6-user-move-sdkv2-identity"
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		ProtoV5ProviderFactories: map[string]func() (tfprotov5.ProviderServer, error){
+			"corner": func() (tfprotov5.ProviderServer, error) { //nolint
+				return sdkv2.New().GRPCProvider(), nil
+			},
+		},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"framework": providerserver.NewProtocol6WithError(New()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: configResourceUserMoveFromSDKv2(namespace, "corner_user_identity", false),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity("corner_user_identity.old", map[string]knownvalue.Check{
+						"email": knownvalue.StringExact("ford@prefect.co"),
+					}),
+				},
+			},
+			{
+				Config: configResourceUserMoveFromSDKv2(namespace, "corner_user_identity", true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("framework_user.new", tfjsonpath.New("name"), knownvalue.StringExact("Ford Prefect")),
+					statecheck.ExpectKnownValue("framework_user.new", tfjsonpath.New("version"), knownvalue.Int64Exact(1)),
+					statecheck.ExpectIdentity("framework_user.new", map[string]knownvalue.Check{
+						"email": knownvalue.StringExact("ford@prefect.co"),
+					}),
+				},
+			},
+		},
+	})
+}
+
+// The protocol provider does not manage users, so its resource stores the email
+// of a user created outside of Terraform.
+func TestAccFrameworkResourceUser_moveFromProtocol(t *testing.T) {
+	cornertesting.IsolateBackend(t, backend.DefaultNamespace)
+
+	resource.UnitTest(t, resource.TestCase{
+		// Write-only attributes are only available in 1.11.0+
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"framework": providerserver.NewProtocol6WithError(New()),
+			"corner": func() (tfprotov6.ProviderServer, error) { //nolint
+				return protocolv6.Server(false), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				PreConfig: cornertesting.PreConfigDrift(t, backend.DefaultNamespace,
+					cornertesting.InjectUser(backend.User{Email: "ford@prefect.co", Name: "Ford Prefect", Age: 200, Version: 1}),
+				),
+				Config: `
+resource "corner_v6_writeonly_datacheck" "old" {
+  email          = "ford@prefect.co"
+  writeonly_attr = "hello world!"
+}
+`,
+			},
+			{
+				Config: `
+moved {
+  from = corner_v6_writeonly_datacheck.old
+  to   = framework_user.new
+}
+
+resource "framework_user" "new" {
+  email = "ford@prefect.co"
+  name  = "Ford Prefect"
+  age   = 200
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("framework_user.new", tfjsonpath.New("email"), knownvalue.StringExact("ford@prefect.co")),
+					statecheck.ExpectKnownValue("framework_user.new", tfjsonpath.New("version"), knownvalue.Int64Exact(1)),
+				},
+			},
+		},
+	})
+}
+
 // Reference: https://github.com/hashicorp/terraform-plugin-sdk/issues/935
 func TestAccFrameworkResourceUser_TF_VAR_Environment_Variable(t *testing.T) {
 	expectedUserName := "Ford Prefect"
 	t.Setenv("TF_VAR_framework_user_name", expectedUserName)
 
+	cornertesting.IsolateBackend(t, backend.DefaultNamespace)
+
 	resource.UnitTest(t, resource.TestCase{
 		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
 			"framework": providerserver.NewProtocol6WithError(New()),
@@ -159,3 +950,269 @@ resource "framework_user" "foo" {
   language = random_shuffle.foo.result[0]
 }
 `
+
+const configResourceUserNamespace = `
+provider "framework" {
+  alias     = "a"
+  namespace = "framework6-user-namespace-a"
+}
+
+provider "framework" {
+  alias     = "b"
+  namespace = "framework6-user-namespace-b"
+}
+
+resource "framework_user" "a" {
+  provider = framework.a
+
+  email = "ford@prefect.co"
+  name  = "Ford Prefect"
+  age   = 200
+}
+
+resource "framework_user" "b" {
+  provider = framework.b
+
+  email = "ford@prefect.co"
+  name  = "Arthur Dent"
+  age   = 42
+}
+`
+
+const configResourceUserPersistencePath = `
+provider "framework" {
+  persistence_path = %q
+}
+
+resource "framework_user" "foo" {
+  email = "ford@prefect.co"
+  name  = "Ford Prefect"
+  age   = 200
+}
+`
+
+const configResourceUserImport = `
+provider "framework" {
+  namespace = "framework6-user-import"
+}
+
+resource "framework_user" "foo" {
+  email = "ford@prefect.co"
+  name  = "Ford Prefect"
+  age   = 200
+}
+`
+
+const configResourceUserFault = `
+provider "framework" {
+  namespace = "framework6-user-fault"
+
+  fault {
+    operation = "CreateUser"
+    error     = "service unavailable"
+  }
+}
+
+resource "framework_user" "foo" {
+  email = "ford@prefect.co"
+  name  = "Ford Prefect"
+  age   = 200
+}
+`
+
+func configResourceUserFaultInjector(name string) string {
+	return fmt.Sprintf(`
+provider "framework" {
+  namespace = "framework6-user-fault-injector"
+}
+
+resource "framework_user" "foo" {
+  email = "ford@prefect.co"
+  name  = %q
+  age   = 200
+}
+`, name)
+}
+
+func configResourceUserVersion(namespace, name string) string {
+	return fmt.Sprintf(`
+provider "framework" {
+  namespace = %q
+}
+
+resource "framework_user" "foo" {
+  email = "ford@prefect.co"
+  name  = %q
+  age   = 200
+}
+`, namespace, name)
+}
+
+const configResourceUserEventualConsistency = `
+provider "framework" {
+  namespace              = "framework6-user-eventual-consistency"
+  read_after_write_delay = "2s"
+}
+
+resource "framework_user" "foo" {
+  email = "ford@prefect.co"
+  name  = "Ford Prefect"
+  age   = 200
+}
+`
+
+func configResourceUserEventualConsistencyUpdate(name string) string {
+	return fmt.Sprintf(`
+provider "framework" {
+  namespace              = "framework6-user-eventual-consistency-update"
+  read_after_write_delay = "2s"
+}
+
+resource "framework_user" "foo" {
+  email = "ford@prefect.co"
+  name  = %q
+  age   = 200
+}
+`, name)
+}
+
+func configResourceUserEndpoint(endpoint, name string) string {
+	return fmt.Sprintf(`
+provider "framework" {
+  endpoint = %q
+}
+
+resource "framework_user" "foo" {
+  email = "ford@prefect.co"
+  name  = %q
+  age   = 200
+}
+`, endpoint, name)
+}
+
+func configResourceUserDrift(namespace string) string {
+	return fmt.Sprintf(`
+provider "framework" {
+  namespace = %q
+}
+
+resource "framework_user" "foo" {
+  email    = "ford@prefect.co"
+  name     = "Ford Prefect"
+  age      = 200
+  language = "en"
+}
+`, namespace)
+}
+
+func configResourceUserDefaultTags(namespace, environment string) string {
+	return fmt.Sprintf(`
+provider "framework" {
+  namespace = %q
+
+  default_tags = {
+    environment = %q
+    owner       = "zaphod"
+  }
+}
+
+resource "framework_user" "foo" {
+  email = "ford@prefect.co"
+  name  = "Ford Prefect"
+  age   = 200
+
+  tags = {
+    owner  = "ford"
+    planet = "betelgeuse"
+  }
+}
+`, namespace, environment)
+}
+
+func configResourceUserSoftDeleteProvider(namespace string) string {
+	return fmt.Sprintf(`
+provider "framework" {
+  namespace             = %q
+  soft_delete_retention = "1h"
+}
+`, namespace)
+}
+
+func configResourceUserSoftDelete(namespace, name string, restoreOnCreate bool) string {
+	return configResourceUserSoftDeleteProvider(namespace) + fmt.Sprintf(`
+resource "framework_user" "foo" {
+  email             = "ford@prefect.co"
+  name              = %q
+  age               = 200
+  restore_on_create = %t
+}
+`, name, restoreOnCreate)
+}
+
+const configResourceUserRateLimit = `
+provider "framework" {
+  namespace        = "framework6-user-rate-limit"
+  rate_limit       = 5
+  rate_limit_burst = 1
+}
+
+resource "framework_user" "foo" {
+  count = 3
+
+  email = "user${count.index}@prefect.co"
+  name  = "Ford Prefect"
+  age   = 200
+}
+`
+
+func configResourceUserQuota(email, name string) string {
+	return fmt.Sprintf(`
+provider "framework" {
+  namespace = "framework6-user-quota"
+  quotas = {
+    users = 1
+  }
+}
+
+resource "framework_user" "foo" {
+  email = %q
+  name  = %q
+  age   = 200
+}
+`, email, name)
+}
+
+func configResourceUserMoveFromSDKv2(namespace, sourceType string, moved bool) string {
+	config := fmt.Sprintf(`
+provider "framework" {
+  namespace = %q
+}
+
+provider "corner" {
+  namespace = %q
+}
+`, namespace, namespace)
+
+	if !moved {
+		return config + fmt.Sprintf(`
+resource %q "old" {
+  email = "ford@prefect.co"
+  name  = "Ford Prefect"
+  age   = 200
+}
+`, sourceType)
+	}
+
+	return config + fmt.Sprintf(`
+moved {
+  from = %s.old
+  to   = framework_user.new
+}
+
+resource "framework_user" "new" {
+  email = "ford@prefect.co"
+  name  = "Ford Prefect"
+  age   = 200
+}
+`, sourceType)
+}
@@ -0,0 +1,281 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = SchemaDataSource{}
+
+func NewSchemaDataSource() datasource.DataSource {
+	return &SchemaDataSource{}
+}
+
+// SchemaDataSource is for testing all schema types.
+type SchemaDataSource struct{}
+
+func (d SchemaDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schema"
+}
+
+func (d SchemaDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"bool_attribute": schema.BoolAttribute{
+				Optional: true,
+			},
+			"computed_dynamic_attribute": schema.DynamicAttribute{
+				Computed: true,
+			},
+			"computed_list_attribute": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"computed_list_nested_attribute": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"computed_list_nested_attribute_attribute": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+				Computed: true,
+			},
+			"computed_object_attribute": schema.ObjectAttribute{
+				AttributeTypes: map[string]attr.Type{
+					"object_attribute_attribute": types.StringType,
+				},
+				Computed: true,
+			},
+			"computed_string_attribute": schema.StringAttribute{
+				Computed: true,
+			},
+			"dynamic_attribute": schema.DynamicAttribute{
+				Optional: true,
+			},
+			"float32_attribute": schema.Float32Attribute{
+				Optional: true,
+			},
+			"float64_attribute": schema.Float64Attribute{
+				Optional: true,
+			},
+			"int32_attribute": schema.Int32Attribute{
+				Optional: true,
+			},
+			"int64_attribute": schema.Int64Attribute{
+				Optional: true,
+			},
+			"list_attribute": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"list_nested_attribute": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"list_nested_attribute_attribute": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+				Optional: true,
+			},
+			"map_attribute": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"map_nested_attribute": schema.MapNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"map_nested_attribute_attribute": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+				Optional: true,
+			},
+			"number_attribute": schema.NumberAttribute{
+				Optional: true,
+			},
+			"object_attribute": schema.ObjectAttribute{
+				AttributeTypes: map[string]attr.Type{
+					"object_attribute_attribute": types.StringType,
+				},
+				Optional: true,
+			},
+			"object_attribute_with_dynamic": schema.ObjectAttribute{
+				AttributeTypes: map[string]attr.Type{
+					"dynamic_attribute": types.DynamicType,
+				},
+				Optional: true,
+			},
+			"optional_computed_int64_attribute": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+			},
+			"optional_computed_single_nested_attribute": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"optional_computed_single_nested_attribute_attribute": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+				Optional: true,
+				Computed: true,
+			},
+			"optional_computed_string_attribute": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"set_attribute": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"set_nested_attribute": schema.SetNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"set_nested_attribute_attribute": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+				Optional: true,
+			},
+			"single_nested_attribute": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"single_nested_attribute_attribute": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+				Optional: true,
+			},
+			"single_nested_attribute_with_dynamic": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"dynamic_attribute": schema.DynamicAttribute{
+						Optional: true,
+					},
+				},
+				Optional: true,
+			},
+			"string_attribute": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"list_nested_block": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"list_nested_block_attribute": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+			"set_nested_block": schema.SetNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"set_nested_block_attribute": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+			"single_nested_block": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"single_nested_block_attribute": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+			"single_nested_block_with_dynamic": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"dynamic_attribute": schema.DynamicAttribute{
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d SchemaDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SchemaDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ComputedDynamicAttribute = types.DynamicValue(types.StringValue("computed"))
+	data.ComputedListAttribute = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("computed")})
+	data.ComputedListNestedAttribute = types.ListValueMust(
+		types.ObjectType{AttrTypes: map[string]attr.Type{"computed_list_nested_attribute_attribute": types.StringType}},
+		[]attr.Value{
+			types.ObjectValueMust(
+				map[string]attr.Type{"computed_list_nested_attribute_attribute": types.StringType},
+				map[string]attr.Value{"computed_list_nested_attribute_attribute": types.StringValue("computed")},
+			),
+		},
+	)
+	data.ComputedObjectAttribute = types.ObjectValueMust(
+		map[string]attr.Type{"object_attribute_attribute": types.StringType},
+		map[string]attr.Value{"object_attribute_attribute": types.StringValue("computed")},
+	)
+	data.ComputedStringAttribute = types.StringValue("computed")
+
+	if data.OptionalComputedInt64Attribute.IsNull() {
+		data.OptionalComputedInt64Attribute = types.Int64Value(1234)
+	}
+
+	if data.OptionalComputedSingleNestedAttribute.IsNull() {
+		data.OptionalComputedSingleNestedAttribute = types.ObjectValueMust(
+			map[string]attr.Type{"optional_computed_single_nested_attribute_attribute": types.StringType},
+			map[string]attr.Value{"optional_computed_single_nested_attribute_attribute": types.StringValue("computed")},
+		)
+	}
+
+	if data.OptionalComputedStringAttribute.IsNull() {
+		data.OptionalComputedStringAttribute = types.StringValue("computed")
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+type SchemaDataSourceModel struct {
+	BoolAttribute                         types.Bool    `tfsdk:"bool_attribute"`
+	ComputedDynamicAttribute              types.Dynamic `tfsdk:"computed_dynamic_attribute"`
+	ComputedListAttribute                 types.List    `tfsdk:"computed_list_attribute"`
+	ComputedListNestedAttribute           types.List    `tfsdk:"computed_list_nested_attribute"`
+	ComputedObjectAttribute               types.Object  `tfsdk:"computed_object_attribute"`
+	ComputedStringAttribute               types.String  `tfsdk:"computed_string_attribute"`
+	DynamicAttribute                      types.Dynamic `tfsdk:"dynamic_attribute"`
+	Float32Attribute                      types.Float32 `tfsdk:"float32_attribute"`
+	Float64Attribute                      types.Float64 `tfsdk:"float64_attribute"`
+	Int32Attribute                        types.Int32   `tfsdk:"int32_attribute"`
+	Int64Attribute                        types.Int64   `tfsdk:"int64_attribute"`
+	ListAttribute                         types.List    `tfsdk:"list_attribute"`
+	ListNestedAttribute                   types.List    `tfsdk:"list_nested_attribute"`
+	ListNestedBlock                       types.List    `tfsdk:"list_nested_block"`
+	MapAttribute                          types.Map     `tfsdk:"map_attribute"`
+	MapNestedAttribute                    types.Map     `tfsdk:"map_nested_attribute"`
+	NumberAttribute                       types.Number  `tfsdk:"number_attribute"`
+	ObjectAttribute                       types.Object  `tfsdk:"object_attribute"`
+	ObjectAttributeWithDynamic            types.Object  `tfsdk:"object_attribute_with_dynamic"`
+	OptionalComputedInt64Attribute        types.Int64   `tfsdk:"optional_computed_int64_attribute"`
+	OptionalComputedSingleNestedAttribute types.Object  `tfsdk:"optional_computed_single_nested_attribute"`
+	OptionalComputedStringAttribute       types.String  `tfsdk:"optional_computed_string_attribute"`
+	SetAttribute                          types.Set     `tfsdk:"set_attribute"`
+	SetNestedAttribute                    types.Set     `tfsdk:"set_nested_attribute"`
+	SetNestedBlock                        types.Set     `tfsdk:"set_nested_block"`
+	SingleNestedAttribute                 types.Object  `tfsdk:"single_nested_attribute"`
+	SingleNestedAttributeWithDynamic      types.Object  `tfsdk:"single_nested_attribute_with_dynamic"`
+	SingleNestedBlock                     types.Object  `tfsdk:"single_nested_block"`
+	SingleNestedBlockWithDynamic          types.Object  `tfsdk:"single_nested_block_with_dynamic"`
+	StringAttribute                       types.String  `tfsdk:"string_attribute"`
+}
@@ -5,35 +5,83 @@ package framework
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-provider-corner/internal/backend"
+)
+
+var (
+	_ resource.Resource              = &TimeoutsResource{}
+	_ resource.ResourceWithConfigure = &TimeoutsResource{}
 )
 
-var _ resource.Resource = TimeoutsResource{}
+// timeoutsResourceDefaultTimeout applies to operations without a configured
+// timeout.
+const timeoutsResourceDefaultTimeout = 20 * time.Minute
 
 func NewTimeoutsResource() resource.Resource {
 	return &TimeoutsResource{}
 }
 
-// TimeoutsResource is for testing all schema types.
-type TimeoutsResource struct{}
+// TimeoutsResource is for testing timeouts. Create, update and delete each
+// start a backend async operation, and wait for it until the configured
+// timeout elapses.
+type TimeoutsResource struct {
+	client *backend.Client
+}
 
-func (r TimeoutsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+func (r *TimeoutsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_timeouts"
 }
 
-func (r TimeoutsResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *TimeoutsResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the backend operation which created the resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"duration": schema.StringAttribute{
+				Optional:    true,
+				Description: "How long each backend operation runs for, such as 10s. Defaults to 0s.",
+			},
+			"error": schema.StringAttribute{
+				Optional:    true,
+				Description: "Makes each backend operation fail with the message.",
+			},
 			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
 				Create: true,
+				Update: true,
+				Delete: true,
 			}),
 		},
 	}
 }
 
+func (r *TimeoutsResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		return
+	}
+
+	r.client = data.client
+}
+
 func (r TimeoutsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data TimeoutsResourceModel
 
@@ -43,6 +91,24 @@ func (r TimeoutsResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, timeoutsResourceDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	id, err := r.run(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating timeouts resource", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(id)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -67,12 +133,81 @@ func (r TimeoutsResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, timeoutsResourceDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	if _, err := r.run(ctx, data); err != nil {
+		resp.Diagnostics.AddError("Error updating timeouts resource", err.Error())
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r TimeoutsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data TimeoutsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, timeoutsResourceDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	if _, err := r.run(ctx, data); err != nil {
+		resp.Diagnostics.AddError("Error deleting timeouts resource", err.Error())
+		return
+	}
+}
+
+// run starts a backend async operation as configured, and waits for it until
+// ctx is done. It returns the ID of the operation.
+func (r TimeoutsResource) run(ctx context.Context, data TimeoutsResourceModel) (string, error) {
+	var duration time.Duration
+
+	if !data.Duration.IsNull() {
+		var err error
+
+		duration, err = time.ParseDuration(data.Duration.ValueString())
+		if err != nil {
+			return "", fmt.Errorf("invalid duration %q: %w", data.Duration.ValueString(), err)
+		}
+	}
+
+	id, err := r.client.StartAsyncOperation(backend.AsyncOperationRequest{
+		RunningDuration: duration,
+		Error:           data.Error.ValueString(),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := r.client.WaitForAsyncOperation(ctx, id); err != nil {
+		return id, err
+	}
+
+	return id, nil
 }
 
 type TimeoutsResourceModel struct {
+	ID       types.String   `tfsdk:"id"`
+	Duration types.String   `tfsdk:"duration"`
+	Error    types.String   `tfsdk:"error"`
 	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
@@ -0,0 +1,293 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-corner/internal/backend"
+)
+
+var _ resource.Resource = &UserIdentityUpgradeResource{}
+var _ resource.ResourceWithConfigure = &UserIdentityUpgradeResource{}
+var _ resource.ResourceWithIdentity = &UserIdentityUpgradeResource{}
+var _ resource.ResourceWithUpgradeIdentity = &UserIdentityUpgradeResource{}
+
+func NewUserIdentityUpgradeResource(version int64) resource.Resource {
+	return &UserIdentityUpgradeResource{
+		version: version,
+	}
+}
+
+// UserIdentityUpgradeResource is a user whose identity is reshaped by each
+// identity schema version:
+//
+//   - 0: the email
+//   - 1: the local part and the domain of the email
+//   - 2: the local part and the labels of the domain of the email
+type UserIdentityUpgradeResource struct {
+	client *backend.Client
+
+	// version is the identity schema version, allowing the calling code to
+	// simulate an identity upgrade with a single resource implementation.
+	version int64
+}
+
+type UserIdentityUpgradeResourceModel struct {
+	Email string `tfsdk:"email"`
+	Name  string `tfsdk:"name"`
+	Age   int64  `tfsdk:"age"`
+}
+
+type userIdentityV0 struct {
+	Email string `tfsdk:"email"`
+}
+
+type userIdentityV1 struct {
+	LocalPart string `tfsdk:"local_part"`
+	Domain    string `tfsdk:"domain"`
+}
+
+type userIdentityV2 struct {
+	LocalPart    string   `tfsdk:"local_part"`
+	DomainLabels []string `tfsdk:"domain_labels"`
+}
+
+func (r *UserIdentityUpgradeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_identity_upgrade"
+}
+
+func (r *UserIdentityUpgradeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"email": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"age": schema.Int64Attribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (r *UserIdentityUpgradeResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	if r.version > 2 {
+		resp.Diagnostics.AddError("Unknown identity version", fmt.Sprintf("Identity version %d is not supported.", r.version))
+		return
+	}
+
+	resp.IdentitySchema = userIdentitySchema(r.version)
+}
+
+func userIdentitySchema(version int64) identityschema.Schema {
+	switch version {
+	case 0:
+		return identityschema.Schema{
+			Attributes: map[string]identityschema.Attribute{
+				"email": identityschema.StringAttribute{
+					RequiredForImport: true,
+				},
+			},
+		}
+	case 1:
+		return identityschema.Schema{
+			Version: 1,
+			Attributes: map[string]identityschema.Attribute{
+				"local_part": identityschema.StringAttribute{
+					RequiredForImport: true,
+				},
+				"domain": identityschema.StringAttribute{
+					RequiredForImport: true,
+				},
+			},
+		}
+	default:
+		return identityschema.Schema{
+			Version: 2,
+			Attributes: map[string]identityschema.Attribute{
+				"local_part": identityschema.StringAttribute{
+					RequiredForImport: true,
+				},
+				"domain_labels": identityschema.ListAttribute{
+					ElementType:       types.StringType,
+					RequiredForImport: true,
+				},
+			},
+		}
+	}
+}
+
+// UpgradeIdentity upgrades every prior version directly to the current
+// version. Version 0 is upgraded with its prior schema, and version 1 from
+// its raw JSON.
+func (r *UserIdentityUpgradeResource) UpgradeIdentity(context.Context) map[int64]resource.IdentityUpgrader {
+	upgraders := map[int64]resource.IdentityUpgrader{}
+
+	if r.version > 0 {
+		priorSchema := userIdentitySchema(0)
+
+		upgraders[0] = resource.IdentityUpgrader{
+			PriorSchema: &priorSchema,
+			IdentityUpgrader: func(ctx context.Context, req resource.UpgradeIdentityRequest, resp *resource.UpgradeIdentityResponse) {
+				var prior userIdentityV0
+				resp.Diagnostics.Append(req.Identity.Get(ctx, &prior)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				r.setIdentity(ctx, prior.Email, resp.Identity, &resp.Diagnostics)
+			},
+		}
+	}
+
+	if r.version > 1 {
+		upgraders[1] = resource.IdentityUpgrader{
+			IdentityUpgrader: func(ctx context.Context, req resource.UpgradeIdentityRequest, resp *resource.UpgradeIdentityResponse) {
+				var prior struct {
+					LocalPart string `json:"local_part"`
+					Domain    string `json:"domain"`
+				}
+
+				if err := json.Unmarshal(req.RawIdentity.JSON, &prior); err != nil {
+					resp.Diagnostics.AddError("Unable to unmarshal prior identity", err.Error())
+					return
+				}
+
+				r.setIdentity(ctx, prior.LocalPart+"@"+prior.Domain, resp.Identity, &resp.Diagnostics)
+			},
+		}
+	}
+
+	return upgraders
+}
+
+// setIdentity sets the identity of the user with the given email in the
+// shape of the current identity version.
+func (r *UserIdentityUpgradeResource) setIdentity(ctx context.Context, email string, identity *tfsdk.ResourceIdentity, diags *diag.Diagnostics) {
+	localPart, domain, ok := strings.Cut(email, "@")
+	if !ok {
+		diags.AddError("Invalid email", fmt.Sprintf("Expected email to contain @, got: %s", email))
+		return
+	}
+
+	switch r.version {
+	case 0:
+		diags.Append(identity.Set(ctx, userIdentityV0{Email: email})...)
+	case 1:
+		diags.Append(identity.Set(ctx, userIdentityV1{LocalPart: localPart, Domain: domain})...)
+	default:
+		diags.Append(identity.Set(ctx, userIdentityV2{LocalPart: localPart, DomainLabels: strings.Split(domain, ".")})...)
+	}
+}
+
+func (r *UserIdentityUpgradeResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		return
+	}
+
+	r.client = data.client
+}
+
+func (r *UserIdentityUpgradeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan UserIdentityUpgradeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.CreateUser(&backend.User{
+		Email: plan.Email,
+		Name:  plan.Name,
+		Age:   int(plan.Age),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating user", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	r.setIdentity(ctx, plan.Email, resp.Identity, &resp.Diagnostics)
+}
+
+func (r *UserIdentityUpgradeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state UserIdentityUpgradeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	p, err := r.client.ReadUser(state.Email)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading user", err.Error())
+		return
+	}
+
+	if p == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Name = p.Name
+	state.Age = int64(p.Age)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	r.setIdentity(ctx, state.Email, resp.Identity, &resp.Diagnostics)
+}
+
+func (r *UserIdentityUpgradeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan UserIdentityUpgradeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.UpdateUser(&backend.User{
+		Email: plan.Email,
+		Name:  plan.Name,
+		Age:   int(plan.Age),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating user", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	r.setIdentity(ctx, plan.Email, resp.Identity, &resp.Diagnostics)
+}
+
+func (r *UserIdentityUpgradeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state UserIdentityUpgradeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteUser(&backend.User{Email: state.Email})
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting user", err.Error())
+		return
+	}
+}
@@ -78,8 +78,36 @@ func (s *server) PrepareProviderConfig(ctx context.Context, req *tfprotov5.Prepa
 }
 
 func (s *server) ConfigureProvider(ctx context.Context, req *tfprotov5.ConfigureProviderRequest) (*tfprotov5.ConfigureProviderResponse, error) {
+	config, diag := dynamicValueToValue(s.providerSchema, req.Config)
+	if diag != nil {
+		return &tfprotov5.ConfigureProviderResponse{
+			Diagnostics: []*tfprotov5.Diagnostic{diag},
+		}, nil
+	}
+
+	var opts []backend.Option
+
+	endpoint, err := configString(config, "endpoint")
+	if err != nil {
+		return &tfprotov5.ConfigureProviderResponse{
+			Diagnostics: []*tfprotov5.Diagnostic{
+				{
+					Summary:  "Error configuring provider",
+					Detail:   fmt.Sprintf("Error reading endpoint: %s", err.Error()),
+					Severity: tfprotov5.DiagnosticSeverityError,
+				},
+			},
+		}, nil
+	}
+	if endpoint != "" {
+		opts = append(opts,
+			backend.WithEndpoint(endpoint),
+			backend.WithUserAgent(fmt.Sprintf("Terraform/%s terraform-provider-corner/protocolv5", req.TerraformVersion)),
+		)
+	}
+
 	var diags []*tfprotov5.Diagnostic
-	client, err := backend.NewClient()
+	client, err := backend.NewClient(opts...)
 	if err != nil {
 		diags = append(diags, &tfprotov5.Diagnostic{
 			Summary:  "Error configuring provider",
@@ -93,6 +121,26 @@ func (s *server) ConfigureProvider(ctx context.Context, req *tfprotov5.Configure
 	}, nil
 }
 
+// configString returns the value of the string attribute of the provider
+// configuration, or an empty string if it is null.
+func configString(config tftypes.Value, name string) (string, error) {
+	var attributes map[string]tftypes.Value
+	if err := config.As(&attributes); err != nil {
+		return "", err
+	}
+
+	var value *string
+	if err := attributes[name].As(&value); err != nil {
+		return "", err
+	}
+
+	if value == nil {
+		return "", nil
+	}
+
+	return *value, nil
+}
+
 func (s *server) StopProvider(ctx context.Context, req *tfprotov5.StopProviderRequest) (*tfprotov5.StopProviderResponse, error) {
 	return &tfprotov5.StopProviderResponse{}, nil
 }
@@ -155,6 +203,11 @@ func Server(upgradeResourceDataError bool) tfprotov5.ProviderServer {
 						Type:     tftypes.Bool,
 						Optional: true,
 					},
+					{
+						Name:     "endpoint",
+						Type:     tftypes.String,
+						Optional: true,
+					},
 				},
 			},
 		},
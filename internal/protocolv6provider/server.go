@@ -78,8 +78,36 @@ func (s *server) ValidateProviderConfig(ctx context.Context, req *tfprotov6.Vali
 }
 
 func (s *server) ConfigureProvider(ctx context.Context, req *tfprotov6.ConfigureProviderRequest) (*tfprotov6.ConfigureProviderResponse, error) {
+	config, diag := dynamicValueToValue(s.providerSchema, req.Config)
+	if diag != nil {
+		return &tfprotov6.ConfigureProviderResponse{
+			Diagnostics: []*tfprotov6.Diagnostic{diag},
+		}, nil
+	}
+
+	var opts []backend.Option
+
+	endpoint, err := configString(config, "endpoint")
+	if err != nil {
+		return &tfprotov6.ConfigureProviderResponse{
+			Diagnostics: []*tfprotov6.Diagnostic{
+				{
+					Summary:  "Error configuring provider",
+					Detail:   fmt.Sprintf("Error reading endpoint: %s", err.Error()),
+					Severity: tfprotov6.DiagnosticSeverityError,
+				},
+			},
+		}, nil
+	}
+	if endpoint != "" {
+		opts = append(opts,
+			backend.WithEndpoint(endpoint),
+			backend.WithUserAgent(fmt.Sprintf("Terraform/%s terraform-provider-corner/protocolv6", req.TerraformVersion)),
+		)
+	}
+
 	var diags []*tfprotov6.Diagnostic
-	client, err := backend.NewClient()
+	client, err := backend.NewClient(opts...)
 	if err != nil {
 		diags = append(diags, &tfprotov6.Diagnostic{
 			Summary:  "Error configuring provider",
@@ -93,6 +121,26 @@ func (s *server) ConfigureProvider(ctx context.Context, req *tfprotov6.Configure
 	}, nil
 }
 
+// configString returns the value of the string attribute of the provider
+// configuration, or an empty string if it is null.
+func configString(config tftypes.Value, name string) (string, error) {
+	var attributes map[string]tftypes.Value
+	if err := config.As(&attributes); err != nil {
+		return "", err
+	}
+
+	var value *string
+	if err := attributes[name].As(&value); err != nil {
+		return "", err
+	}
+
+	if value == nil {
+		return "", nil
+	}
+
+	return *value, nil
+}
+
 func (s *server) StopProvider(ctx context.Context, req *tfprotov6.StopProviderRequest) (*tfprotov6.StopProviderResponse, error) {
 	return &tfprotov6.StopProviderResponse{}, nil
 }
@@ -148,7 +196,15 @@ func (s *server) CloseEphemeralResource(context.Context, *tfprotov6.CloseEphemer
 func Server(upgradeResourceDataError bool) tfprotov6.ProviderServer {
 	return &server{
 		providerSchema: &tfprotov6.Schema{
-			Block: &tfprotov6.SchemaBlock{},
+			Block: &tfprotov6.SchemaBlock{
+				Attributes: []*tfprotov6.SchemaAttribute{
+					{
+						Name:     "endpoint",
+						Type:     tftypes.String,
+						Optional: true,
+					},
+				},
+			},
 		},
 		dataSourceSchemas: map[string]*tfprotov6.Schema{
 			"corner_v6_time": {
@@ -0,0 +1,63 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package protocolv6
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-provider-corner/internal/backend"
+)
+
+// The protocol provider has no resources backed by the client, so the client
+// configured with an endpoint is checked directly.
+func TestServer_endpoint(t *testing.T) {
+	t.Parallel()
+
+	remote, err := backend.NewClient(backend.WithFreshDatabase())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	err = remote.CreateUser(&backend.User{Email: "ford@prefect.co", Name: "Ford Prefect", Age: 200})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ts := httptest.NewServer(backend.NewHandler(remote))
+	t.Cleanup(ts.Close)
+
+	s := Server(false).(*server)
+	ctx := context.Background()
+
+	configType := s.providerSchema.ValueType()
+	config, err := tfprotov6.NewDynamicValue(configType, tftypes.NewValue(configType, map[string]tftypes.Value{
+		"endpoint": tftypes.NewValue(tftypes.String, ts.URL),
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	resp, err := s.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{Config: &config})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, d := range resp.Diagnostics {
+		t.Fatalf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+	}
+
+	got, err := s.client.ReadUser("ford@prefect.co")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got == nil || got.Name != "Ford Prefect" {
+		t.Errorf("expected user to be read from %s, got: %+v", ts.URL, got)
+	}
+}
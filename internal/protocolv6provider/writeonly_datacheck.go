@@ -33,6 +33,12 @@ func (r resourceWriteOnlyDataCheck) schema() *tfprotov6.Schema {
 					Type:     tftypes.String,
 					Computed: true,
 				},
+				{
+					// Only used for moving to a framework_user
+					Name:     "email",
+					Type:     tftypes.String,
+					Optional: true,
+				},
 				{
 					Name:      "writeonly_attr",
 					Type:      tftypes.String,
@@ -45,13 +51,14 @@ func (r resourceWriteOnlyDataCheck) schema() *tfprotov6.Schema {
 }
 
 // nonNullWriteOnlyData is used to produce data which will raise an error diagnostic in Terraform core.
-func (r resourceWriteOnlyDataCheck) nonNullWriteOnlyData() (tfprotov6.DynamicValue, error) {
+func (r resourceWriteOnlyDataCheck) nonNullWriteOnlyData(email tftypes.Value) (tfprotov6.DynamicValue, error) {
 	return tfprotov6.NewDynamicValue(
 		r.schema().ValueType(),
 		tftypes.NewValue(
 			r.schema().ValueType(),
 			map[string]tftypes.Value{
 				"id":             tftypes.NewValue(tftypes.String, "test-123"),
+				"email":          email,
 				"writeonly_attr": tftypes.NewValue(tftypes.String, "this should cause an error!"),
 			},
 		),
@@ -59,13 +66,14 @@ func (r resourceWriteOnlyDataCheck) nonNullWriteOnlyData() (tfprotov6.DynamicVal
 }
 
 // nullWriteOnlyData is used to produce valid data.
-func (r resourceWriteOnlyDataCheck) nullWriteOnlyData() (tfprotov6.DynamicValue, error) {
+func (r resourceWriteOnlyDataCheck) nullWriteOnlyData(email tftypes.Value) (tfprotov6.DynamicValue, error) {
 	return tfprotov6.NewDynamicValue(
 		r.schema().ValueType(),
 		tftypes.NewValue(
 			r.schema().ValueType(),
 			map[string]tftypes.Value{
 				"id":             tftypes.NewValue(tftypes.String, "test-123"),
+				"email":          email,
 				"writeonly_attr": tftypes.NewValue(tftypes.String, nil),
 			},
 		),
@@ -87,12 +95,19 @@ func (r resourceWriteOnlyDataCheck) ApplyResourceChange(ctx context.Context, req
 		}, nil
 	}
 
+	config, diag := dynamicValueToValue(r.schema(), req.Config)
+	if diag != nil {
+		return &tfprotov6.ApplyResourceChangeResponse{
+			Diagnostics: []*tfprotov6.Diagnostic{diag},
+		}, nil
+	}
+
 	var newState tfprotov6.DynamicValue
 	var err error
 	if r.applyDataError {
-		newState, err = r.nonNullWriteOnlyData()
+		newState, err = r.nonNullWriteOnlyData(stateEmail(config))
 	} else {
-		newState, err = r.nullWriteOnlyData()
+		newState, err = r.nullWriteOnlyData(stateEmail(config))
 	}
 
 	if err != nil {
@@ -131,9 +146,9 @@ func (r resourceWriteOnlyDataCheck) PlanResourceChange(ctx context.Context, req
 	var plannedState tfprotov6.DynamicValue
 	var err error
 	if r.planDataError {
-		plannedState, err = r.nonNullWriteOnlyData()
+		plannedState, err = r.nonNullWriteOnlyData(stateEmail(proposedNewState))
 	} else {
-		plannedState, err = r.nullWriteOnlyData()
+		plannedState, err = r.nullWriteOnlyData(stateEmail(proposedNewState))
 	}
 
 	if err != nil {
@@ -155,12 +170,19 @@ func (r resourceWriteOnlyDataCheck) PlanResourceChange(ctx context.Context, req
 }
 
 func (r resourceWriteOnlyDataCheck) ReadResource(ctx context.Context, req *tfprotov6.ReadResourceRequest) (*tfprotov6.ReadResourceResponse, error) {
+	currentState, diag := dynamicValueToValue(r.schema(), req.CurrentState)
+	if diag != nil {
+		return &tfprotov6.ReadResourceResponse{
+			Diagnostics: []*tfprotov6.Diagnostic{diag},
+		}, nil
+	}
+
 	var newState tfprotov6.DynamicValue
 	var err error
 	if r.readDataError {
-		newState, err = r.nonNullWriteOnlyData()
+		newState, err = r.nonNullWriteOnlyData(stateEmail(currentState))
 	} else {
-		newState, err = r.nullWriteOnlyData()
+		newState, err = r.nullWriteOnlyData(stateEmail(currentState))
 	}
 
 	if err != nil {
@@ -189,9 +211,9 @@ func (r resourceWriteOnlyDataCheck) ImportResourceState(ctx context.Context, req
 	var importedState tfprotov6.DynamicValue
 	var err error
 	if r.importDataError {
-		importedState, err = r.nonNullWriteOnlyData()
+		importedState, err = r.nonNullWriteOnlyData(tftypes.NewValue(tftypes.String, nil))
 	} else {
-		importedState, err = r.nullWriteOnlyData()
+		importedState, err = r.nullWriteOnlyData(tftypes.NewValue(tftypes.String, nil))
 	}
 
 	if err != nil {
@@ -229,12 +251,25 @@ func (r resourceWriteOnlyDataCheck) UpgradeResourceState(ctx context.Context, re
 		}, nil
 	}
 
+	// the email is kept, as Terraform upgrades the state of every refresh
+	rawState, err := req.RawState.Unmarshal(r.schema().ValueType())
+	if err != nil {
+		return &tfprotov6.UpgradeResourceStateResponse{
+			Diagnostics: []*tfprotov6.Diagnostic{
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "Error decoding prior state",
+					Detail:   fmt.Sprintf("Error decoding prior state: %s", err.Error()),
+				},
+			},
+		}, nil
+	}
+
 	var upgradeResourceState tfprotov6.DynamicValue
-	var err error
 	if r.upgradeResourceDataError {
-		upgradeResourceState, err = r.nonNullWriteOnlyData()
+		upgradeResourceState, err = r.nonNullWriteOnlyData(stateEmail(rawState))
 	} else {
-		upgradeResourceState, err = r.nullWriteOnlyData()
+		upgradeResourceState, err = r.nullWriteOnlyData(stateEmail(rawState))
 	}
 
 	if err != nil {
@@ -270,9 +305,9 @@ func (r resourceWriteOnlyDataCheck) MoveResourceState(ctx context.Context, req *
 	var moveResourceState tfprotov6.DynamicValue
 	var err error
 	if r.moveResourceDataError {
-		moveResourceState, err = r.nonNullWriteOnlyData()
+		moveResourceState, err = r.nonNullWriteOnlyData(tftypes.NewValue(tftypes.String, nil))
 	} else {
-		moveResourceState, err = r.nullWriteOnlyData()
+		moveResourceState, err = r.nullWriteOnlyData(tftypes.NewValue(tftypes.String, nil))
 	}
 
 	if err != nil {
@@ -321,3 +356,20 @@ func dynamicValueToValue(schema *tfprotov6.Schema, dynamicValue *tfprotov6.Dynam
 
 	return value, nil
 }
+
+// stateEmail returns the email attribute of a value of the resource, which is
+// null if the value is null.
+func stateEmail(value tftypes.Value) tftypes.Value {
+	var attributes map[string]tftypes.Value
+
+	if !value.IsKnown() || value.As(&attributes) != nil {
+		return tftypes.NewValue(tftypes.String, nil)
+	}
+
+	email, ok := attributes["email"]
+	if !ok {
+		return tftypes.NewValue(tftypes.String, nil)
+	}
+
+	return email
+}
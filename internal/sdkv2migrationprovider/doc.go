@@ -0,0 +1,7 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package sdkv2migrationprovider muxes internal/sdkv2provider with a framework
+// implementation of its corner_user resource, simulating a provider that
+// migrates a resource type from SDKv2 to the framework.
+package sdkv2migrationprovider
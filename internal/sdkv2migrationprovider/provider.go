@@ -0,0 +1,129 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2migrationprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	sdkv2 "github.com/hashicorp/terraform-provider-corner/internal/sdkv2provider"
+)
+
+// New returns the SDKv2 corner provider, with its corner_user resource served
+// by the framework instead.
+func New() (func() tfprotov5.ProviderServer, error) {
+	ctx := context.Background()
+
+	sdkProvider := sdkv2.New()
+	delete(sdkProvider.ResourcesMap, "corner_user")
+
+	// The SDKv2 provider must come first, as the framework provider uses the
+	// backend client it configures.
+	providers := []func() tfprotov5.ProviderServer{
+		sdkProvider.GRPCProvider,
+		providerserver.NewProtocol5(&frameworkProvider{sdkProvider: sdkProvider}),
+	}
+
+	muxServer, err := tf5muxserver.NewMuxServer(ctx, providers...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return muxServer.ProviderServer, nil
+}
+
+var _ provider.Provider = &frameworkProvider{}
+
+// frameworkProvider serves the resources migrated to the framework. It shares
+// the backend client of the SDKv2 provider it is muxed with.
+type frameworkProvider struct {
+	sdkProvider *sdkschema.Provider
+}
+
+func (p *frameworkProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "corner"
+}
+
+// Schema is identical to the schema of the SDKv2 provider, as muxed provider
+// schemas must match.
+func (p *frameworkProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"deferral": schema.BoolAttribute{
+				Optional: true,
+			},
+			"namespace": schema.StringAttribute{
+				Optional: true,
+			},
+			"persistence_path": schema.StringAttribute{
+				Optional: true,
+			},
+			"read_after_write_delay": schema.StringAttribute{
+				Optional: true,
+			},
+			"endpoint": schema.StringAttribute{
+				Optional: true,
+			},
+			"rate_limit": schema.Float64Attribute{
+				Optional: true,
+			},
+			"rate_limit_burst": schema.Int64Attribute{
+				Optional: true,
+			},
+			"quotas": schema.MapAttribute{
+				ElementType: types.Int64Type,
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"fault": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"operation": schema.StringAttribute{
+							Required: true,
+						},
+						"error": schema.StringAttribute{
+							Optional: true,
+						},
+						"latency": schema.StringAttribute{
+							Optional: true,
+						},
+						"call": schema.Int64Attribute{
+							Optional: true,
+						},
+						"after_commit": schema.BoolAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure leaves the validation of the configuration to the SDKv2 provider,
+// which the mux server configures first.
+func (p *frameworkProvider) Configure(_ context.Context, _ provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	resp.ResourceData = p.sdkProvider.Meta()
+}
+
+func (p *frameworkProvider) Resources(_ context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		NewUserResource,
+	}
+}
+
+func (p *frameworkProvider) DataSources(_ context.Context) []func() datasource.DataSource {
+	return nil
+}
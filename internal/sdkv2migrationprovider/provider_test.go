@@ -0,0 +1,50 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2migrationprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	provider, err := New()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	resp, err := provider().GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, d := range resp.Diagnostics {
+		t.Errorf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+	}
+
+	if _, ok := resp.ResourceSchemas["corner_user"]; !ok {
+		t.Error("expected corner_user to be served by the framework provider")
+	}
+
+	if _, ok := resp.ResourceSchemas["corner_group"]; !ok {
+		t.Error("expected corner_group to be served by the SDKv2 provider")
+	}
+}
+
+func protoV5ProviderFactories() map[string]func() (tfprotov5.ProviderServer, error) {
+	return map[string]func() (tfprotov5.ProviderServer, error){
+		"corner": func() (tfprotov5.ProviderServer, error) {
+			provider, err := New()
+			if err != nil {
+				return nil, err
+			}
+
+			return provider(), nil
+		},
+	}
+}
@@ -0,0 +1,336 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2migrationprovider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-provider-corner/internal/backend"
+)
+
+var _ resource.Resource = &resourceUser{}
+var _ resource.ResourceWithConfigure = &resourceUser{}
+var _ resource.ResourceWithUpgradeState = &resourceUser{}
+
+func NewUserResource() resource.Resource {
+	return &resourceUser{}
+}
+
+// resourceUser is the framework implementation of the corner_user resource of
+// internal/sdkv2provider. Version 0 of its state is written by SDKv2, and
+// version 1 drops the id and stores null rather than an empty region.
+type resourceUser struct {
+	client *backend.Client
+}
+
+type user struct {
+	Email   string       `tfsdk:"email"`
+	Name    string       `tfsdk:"name"`
+	Age     int64        `tfsdk:"age"`
+	Region  types.String `tfsdk:"region"`
+	Version types.Int64  `tfsdk:"version"`
+}
+
+// userV0 is the state of corner_user as written by SDKv2, which stores
+// numbers as strings in flatmap state.
+type userV0 struct {
+	ID      string      `json:"id"`
+	Email   string      `json:"email"`
+	Name    string      `json:"name"`
+	Age     json.Number `json:"age"`
+	Region  string      `json:"region"`
+	Version json.Number `json:"version"`
+}
+
+func (r *resourceUser) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (r *resourceUser) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version: 1,
+		Attributes: map[string]schema.Attribute{
+			"email": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"age": schema.Int64Attribute{
+				Required: true,
+			},
+			"region": schema.StringAttribute{
+				Optional: true,
+			},
+			"version": schema.Int64Attribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// UpgradeState upgrades the SDKv2 state, which is flatmap if it was last
+// written by Terraform 0.11 or earlier, and JSON otherwise.
+func (r *resourceUser) UpgradeState(context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior userV0
+
+				switch {
+				case req.RawState.JSON != nil:
+					if err := json.Unmarshal(req.RawState.JSON, &prior); err != nil {
+						resp.Diagnostics.AddError("Unable to unmarshal prior state", err.Error())
+						return
+					}
+				case req.RawState.Flatmap != nil:
+					prior = userV0{
+						ID:      req.RawState.Flatmap["id"],
+						Email:   req.RawState.Flatmap["email"],
+						Name:    req.RawState.Flatmap["name"],
+						Age:     json.Number(req.RawState.Flatmap["age"]),
+						Region:  req.RawState.Flatmap["region"],
+						Version: json.Number(req.RawState.Flatmap["version"]),
+					}
+				default:
+					resp.Diagnostics.AddError("Unable to upgrade prior state", "Expected prior state to be JSON or flatmap.")
+					return
+				}
+
+				upgraded, err := prior.upgrade()
+				if err != nil {
+					resp.Diagnostics.AddError("Unable to upgrade prior state", err.Error())
+					return
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, upgraded)...)
+			},
+		},
+	}
+}
+
+func (u userV0) upgrade() (*user, error) {
+	// SDKv2 sets the id to the email, but only the email is kept
+	if u.Email == "" {
+		u.Email = u.ID
+	}
+
+	age, err := strconv.ParseInt(u.Age.String(), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid age %q: %s", u.Age, err)
+	}
+
+	upgraded := &user{
+		Email:   u.Email,
+		Name:    u.Name,
+		Age:     age,
+		Region:  types.StringNull(),
+		Version: types.Int64Null(),
+	}
+
+	if u.Region != "" {
+		upgraded.Region = types.StringValue(u.Region)
+	}
+
+	if u.Version != "" {
+		version, err := strconv.ParseInt(u.Version.String(), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid version %q: %s", u.Version, err)
+		}
+
+		upgraded.Version = types.Int64Value(version)
+	}
+
+	return upgraded, nil
+}
+
+func (r *resourceUser) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*backend.Client)
+	if !ok {
+		return
+	}
+
+	r.client = client
+}
+
+func (r *resourceUser) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	client := r.client.WithContext(ctx)
+
+	var plan user
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newUser := &backend.User{
+		Email:  plan.Email,
+		Name:   plan.Name,
+		Age:    int(plan.Age),
+		Region: plan.Region.ValueString(),
+	}
+
+	err := retryThrottled(ctx, func() error {
+		return client.CreateUser(newUser)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating user", err.Error())
+		return
+	}
+
+	plan.Version = types.Int64Value(int64(newUser.Version))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceUser) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	client := r.client.WithContext(ctx)
+
+	var state user
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var p *backend.User
+	err := retryThrottled(ctx, func() error {
+		var err error
+		p, err = client.ReadUser(state.Email)
+
+		return err
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading user", err.Error())
+		return
+	}
+
+	if p == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Name = p.Name
+	state.Age = int64(p.Age)
+	state.Region = types.StringNull()
+	if p.Region != "" {
+		state.Region = types.StringValue(p.Region)
+	}
+	state.Version = types.Int64Value(int64(p.Version))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *resourceUser) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	client := r.client.WithContext(ctx)
+
+	var plan, state user
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newUser := &backend.User{
+		Email:  plan.Email,
+		Name:   plan.Name,
+		Age:    int(plan.Age),
+		Region: plan.Region.ValueString(),
+	}
+
+	err := retryThrottled(ctx, func() error {
+		if state.Version.IsNull() {
+			return client.UpdateUser(newUser)
+		}
+
+		return client.UpdateUserIfVersion(newUser, int(state.Version.ValueInt64()))
+	})
+
+	var conflictErr *backend.ConflictError
+	if errors.As(err, &conflictErr) {
+		resp.Diagnostics.AddError("Error updating user", "The user was modified outside of Terraform since it was last read, refresh and try again: "+err.Error())
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating user", err.Error())
+		return
+	}
+
+	plan.Version = types.Int64Value(int64(newUser.Version))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceUser) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	client := r.client.WithContext(ctx)
+
+	var state user
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userToDelete := &backend.User{Email: state.Email}
+
+	err := retryThrottled(ctx, func() error {
+		if state.Version.IsNull() {
+			return client.DeleteUser(userToDelete)
+		}
+
+		return client.DeleteUserIfVersion(userToDelete, int(state.Version.ValueInt64()))
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting user", err.Error())
+		return
+	}
+}
+
+// userThrottledTimeout bounds how long the user resource retries operations
+// that the backend throttled.
+const userThrottledTimeout = time.Minute
+
+// retryThrottled calls fn until it does not fail with a throttling error,
+// waiting for the retry-after hint of the error between calls. It gives up
+// once ctx is done, or would be before the hint elapses.
+func retryThrottled(ctx context.Context, fn func() error) error {
+	ctx, cancel := context.WithTimeout(ctx, userThrottledTimeout)
+	defer cancel()
+
+	for {
+		err := fn()
+
+		after, ok := backend.RetryAfter(err)
+		if !ok {
+			return err
+		}
+
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < after {
+			return fmt.Errorf("giving up retrying, as the deadline is before the retry-after of %s: %w", after, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("giving up retrying: %w", err)
+		case <-time.After(after):
+		}
+	}
+}
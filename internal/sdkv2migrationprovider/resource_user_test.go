@@ -0,0 +1,157 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2migrationprovider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	sdkv2 "github.com/hashicorp/terraform-provider-corner/internal/sdkv2provider"
+)
+
+func TestAccResourceUser_migrateFromSDKv2(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				ProtoV5ProviderFactories: map[string]func() (tfprotov5.ProviderServer, error){
+					"corner": func() (tfprotov5.ProviderServer, error) { //nolint
+						return sdkv2.New().GRPCProvider(), nil
+					},
+				},
+				Config: configResourceUser("Ford Prefect"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("corner_user.foo", tfjsonpath.New("id"), knownvalue.StringExact("ford@prefect.co")),
+					statecheck.ExpectKnownValue("corner_user.foo", tfjsonpath.New("version"), knownvalue.Int64Exact(1)),
+				},
+			},
+			{
+				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				Config:                   configResourceUser("Ford Prefect"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("corner_user.foo", tfjsonpath.New("email"), knownvalue.StringExact("ford@prefect.co")),
+					statecheck.ExpectKnownValue("corner_user.foo", tfjsonpath.New("age"), knownvalue.Int64Exact(200)),
+					statecheck.ExpectKnownValue("corner_user.foo", tfjsonpath.New("region"), knownvalue.Null()),
+					statecheck.ExpectKnownValue("corner_user.foo", tfjsonpath.New("version"), knownvalue.Int64Exact(1)),
+				},
+			},
+			{
+				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				Config:                   configResourceUser("Zaphod Beeblebrox"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("corner_user.foo", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("corner_user.foo", tfjsonpath.New("name"), knownvalue.StringExact("Zaphod Beeblebrox")),
+					statecheck.ExpectKnownValue("corner_user.foo", tfjsonpath.New("version"), knownvalue.Int64Exact(2)),
+				},
+			},
+		},
+	})
+}
+
+// Terraform has not written flatmap state since 0.11, so it is upgraded
+// without the Terraform CLI.
+func TestResourceUser_upgradeState(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]*tfprotov5.RawState{
+		"flatmap": {
+			Flatmap: map[string]string{
+				"id":      "ford@prefect.co",
+				"email":   "ford@prefect.co",
+				"name":    "Ford Prefect",
+				"age":     "200",
+				"region":  "",
+				"version": "1",
+			},
+		},
+		"json": {
+			JSON: []byte(`{"id":"ford@prefect.co","email":"ford@prefect.co","name":"Ford Prefect","age":200,"region":"","version":1}`),
+		},
+		"json-null-region": {
+			JSON: []byte(`{"id":"ford@prefect.co","email":"ford@prefect.co","name":"Ford Prefect","age":200,"region":null,"version":1}`),
+		},
+	}
+
+	for name, rawState := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			provider, err := New()
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			server := provider()
+			ctx := context.Background()
+
+			schemaResp, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			resp, err := server.UpgradeResourceState(ctx, &tfprotov5.UpgradeResourceStateRequest{
+				TypeName: "corner_user",
+				Version:  0,
+				RawState: rawState,
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			for _, d := range resp.Diagnostics {
+				t.Fatalf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+			}
+
+			objectType := schemaResp.ResourceSchemas["corner_user"].ValueType()
+
+			got, err := resp.UpgradedState.Unmarshal(objectType)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			expected := tftypes.NewValue(objectType, map[string]tftypes.Value{
+				"email":   tftypes.NewValue(tftypes.String, "ford@prefect.co"),
+				"name":    tftypes.NewValue(tftypes.String, "Ford Prefect"),
+				"age":     tftypes.NewValue(tftypes.Number, 200),
+				"region":  tftypes.NewValue(tftypes.String, nil),
+				"version": tftypes.NewValue(tftypes.Number, 1),
+			})
+
+			if !got.Equal(expected) {
+				t.Errorf("expected upgraded state %s, got: %s", expected, got)
+			}
+		})
+	}
+}
+
+func configResourceUser(name string) string {
+	return fmt.Sprintf(`
+provider "corner" {
+  namespace = "sdkv2-migration"
+}
+
+resource "corner_user" "foo" {
+  email = "ford@prefect.co"
+  name  = %q
+  age   = 200
+}
+`, name)
+}
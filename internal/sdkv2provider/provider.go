@@ -1,13 +1,17 @@
 // Copyright IBM Corp. 2020, 2026
 // SPDX-License-Identifier: MPL-2.0
 
+//nolint:forcetypeassert // Test SDK provider
 package sdkv2
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-provider-corner/internal/backend"
 )
@@ -19,6 +23,67 @@ func New() *schema.Provider {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"namespace": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"persistence_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"read_after_write_delay": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"endpoint": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"rate_limit": {
+				Type:     schema.TypeFloat,
+				Optional: true,
+			},
+			"rate_limit_burst": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"quotas": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"fault": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"operation": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(faultOperations(), false)),
+						},
+						"error": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"latency": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+						},
+						"call": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"after_commit": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
 		},
 		DataSourcesMap: map[string]*schema.Resource{
 			"corner_regions":     dataSourceRegions(),
@@ -27,6 +92,9 @@ func New() *schema.Provider {
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"corner_user":                              resourceUser(),
+			"corner_group":                             resourceGroup(),
+			"corner_region":                            resourceRegion(),
+			"corner_group_membership":                  resourceGroupMembership(),
 			"corner_user_identity":                     resourceUserIdentity(),
 			"corner_user_identity_upgrade":             resourceUserIdentityUpgrade(0),
 			"corner_writeonly":                         resourceWriteOnly(),
@@ -38,11 +106,70 @@ func New() *schema.Provider {
 			"corner_user_cty":                          resourceUserCty(),
 			"corner_deferred_action":                   resourceDeferredAction(),
 			"corner_deferred_action_plan_modification": resourceDeferredActionPlanModification(),
+			"corner_timeouts":                          resourceTimeouts(),
 		},
 	}
 
-	p.ConfigureProvider = func(ctx context.Context, req schema.ConfigureProviderRequest, resp *schema.ConfigureProviderResponse) {
-		client, err := backend.NewClient()
+	p.ConfigureProvider = configureProvider(p, nil)
+
+	return p
+}
+
+// NewWithFaultInjector returns a provider whose backend client applies the
+// rules of the given FaultInjector, unless overridden by fault blocks in the
+// provider configuration.
+func NewWithFaultInjector(faults *backend.FaultInjector) *schema.Provider {
+	p := New()
+	p.ConfigureProvider = configureProvider(p, faults)
+
+	return p
+}
+
+func configureProvider(p *schema.Provider, faults *backend.FaultInjector) func(context.Context, schema.ConfigureProviderRequest, *schema.ConfigureProviderResponse) {
+	return func(ctx context.Context, req schema.ConfigureProviderRequest, resp *schema.ConfigureProviderResponse) {
+		var opts []backend.Option
+		if namespace, ok := req.ResourceData.Get("namespace").(string); ok && namespace != "" {
+			opts = append(opts, backend.WithNamespace(namespace))
+		}
+		if path, ok := req.ResourceData.Get("persistence_path").(string); ok && path != "" {
+			opts = append(opts, backend.WithPersistence(path))
+		}
+		if delay, ok := req.ResourceData.Get("read_after_write_delay").(string); ok && delay != "" {
+			d, err := time.ParseDuration(delay)
+			if err != nil {
+				resp.Diagnostics = diag.Errorf("invalid read_after_write_delay %q: %s", delay, err)
+				return
+			}
+
+			opts = append(opts, backend.WithReadAfterWriteDelay(d))
+		}
+		if limit, ok := req.ResourceData.Get("rate_limit").(float64); ok && limit > 0 {
+			opts = append(opts, backend.WithRateLimit(limit, req.ResourceData.Get("rate_limit_burst").(int)))
+		}
+		for table, limit := range req.ResourceData.Get("quotas").(map[string]interface{}) {
+			opts = append(opts, backend.WithQuota(table, limit.(int)))
+		}
+		if endpoint, ok := req.ResourceData.Get("endpoint").(string); ok && endpoint != "" {
+			opts = append(opts,
+				backend.WithEndpoint(endpoint),
+				backend.WithUserAgent(p.UserAgent("terraform-provider-corner", "sdkv2")),
+			)
+		}
+
+		rules, err := faultRules(req.ResourceData)
+		if err != nil {
+			resp.Diagnostics = diag.FromErr(err)
+			return
+		}
+		injector := faults
+		if len(rules) > 0 {
+			injector = backend.NewFaultInjector(rules...)
+		}
+		if injector != nil {
+			opts = append(opts, backend.WithFaultInjector(injector))
+		}
+
+		client, err := backend.NewClient(opts...)
 		if err != nil {
 			resp.Diagnostics = diag.FromErr(err)
 		}
@@ -54,8 +181,6 @@ func New() *schema.Provider {
 		}
 		resp.Meta = client
 	}
-
-	return p
 }
 
 func NewWithUpgradeVersion(version int) *schema.Provider {
@@ -71,3 +196,43 @@ func NewWithIdentityUpgradeVersion(version int) *schema.Provider {
 
 	return p
 }
+
+func faultOperations() []string {
+	var operations []string
+
+	for _, op := range backend.Operations() {
+		operations = append(operations, string(op))
+	}
+
+	return operations
+}
+
+func faultRules(d *schema.ResourceData) ([]backend.FaultRule, error) {
+	faults := d.Get("fault").([]interface{})
+
+	rules := make([]backend.FaultRule, 0, len(faults))
+
+	for _, raw := range faults {
+		fault := raw.(map[string]interface{})
+
+		rule := backend.FaultRule{
+			Operation:   backend.Operation(fault["operation"].(string)),
+			Error:       fault["error"].(string),
+			Call:        fault["call"].(int),
+			AfterCommit: fault["after_commit"].(bool),
+		}
+
+		if latency := fault["latency"].(string); latency != "" {
+			d, err := time.ParseDuration(latency)
+			if err != nil {
+				return nil, fmt.Errorf("invalid fault latency %q: %w", latency, err)
+			}
+
+			rule.Latency = d
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
@@ -8,6 +8,9 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-corner/internal/backend"
+	"github.com/hashicorp/terraform-provider-corner/internal/cornertesting"
 )
 
 var testAccProviders map[string]*schema.Provider
@@ -37,6 +40,8 @@ func TestAccTests(t *testing.T) {
 	for name, c := range TestCases {
 		t.Helper()
 		t.Run(name, func(t *testing.T) {
+			cornertesting.IsolateBackend(t, backend.DefaultNamespace)
+
 			resource.Test(t, c(t))
 		})
 	}
@@ -45,6 +50,9 @@ func TestAccTests(t *testing.T) {
 // public map of test cases that can be imported by Core/SDK etc.
 var TestCases = map[string]func(*testing.T) resource.TestCase{
 	"corner_user":                              testAccResourceUser,
+	"corner_group":                             testAccResourceGroup,
+	"corner_group_membership":                  testAccResourceGroupMembership,
+	"corner_region":                            testAccResourceRegion,
 	"corner_user_identity":                     testAccResourceUserIdentity,
 	"corner_user_identity_upgrade":             testAccResourceUserIdentityUpgrade,
 	"corner_regions":                           testAccDataSourceRegions,
@@ -54,4 +62,5 @@ var TestCases = map[string]func(*testing.T) resource.TestCase{
 	"corner_regions_cty":                       testAccDataSourceRegionsCty,
 	"corner_deferred_action":                   testAccResourceDeferredAction,
 	"corner_deferred_action_plan_modification": testAccResourceDeferredActionPlanModification,
+	"corner_timeouts":                          testAccResourceTimeouts,
 }
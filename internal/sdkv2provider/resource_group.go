@@ -0,0 +1,120 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+//nolint:forcetypeassert // Test SDK provider
+package sdkv2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-corner/internal/backend"
+)
+
+func resourceGroup() *schema.Resource {
+	return &schema.Resource{
+		// Prevent any accidental data inconsistencies
+		EnableLegacyTypeSystemPlanErrors:  true,
+		EnableLegacyTypeSystemApplyErrors: true,
+
+		CreateContext: resourceGroupCreate,
+		ReadContext:   resourceGroupRead,
+		UpdateContext: resourceGroupUpdate,
+		DeleteContext: resourceGroupDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				err := d.Set("name", d.Id())
+				if err != nil {
+					return nil, err
+				}
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+	}
+}
+
+func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*backend.Client)
+	group := &backend.Group{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+	}
+
+	err := client.CreateGroup(group)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGroupRead(ctx, d, meta)
+}
+
+func resourceGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*backend.Client)
+
+	name := d.Get("name").(string)
+
+	g, err := client.ReadGroup(name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if g == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.SetId(name)
+
+	err = d.Set("description", g.Description)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*backend.Client)
+
+	group := &backend.Group{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+	}
+
+	err := client.UpdateGroup(group)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*backend.Client)
+
+	group := &backend.Group{
+		Name: d.Get("name").(string),
+	}
+
+	err := client.DeleteGroup(group)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
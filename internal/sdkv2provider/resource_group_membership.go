@@ -0,0 +1,111 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+//nolint:forcetypeassert // Test SDK provider
+package sdkv2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-corner/internal/backend"
+)
+
+func resourceGroupMembership() *schema.Resource {
+	return &schema.Resource{
+		// Prevent any accidental data inconsistencies
+		EnableLegacyTypeSystemPlanErrors:  true,
+		EnableLegacyTypeSystemApplyErrors: true,
+
+		CreateContext: resourceGroupMembershipCreate,
+		ReadContext:   resourceGroupMembershipRead,
+		DeleteContext: resourceGroupMembershipDelete,
+
+		Schema: map[string]*schema.Schema{
+			"group": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"email": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				group, email, ok := strings.Cut(d.Id(), "/")
+				if !ok || group == "" || email == "" {
+					return nil, fmt.Errorf("expected import ID of the form group/email, got: %q", d.Id())
+				}
+
+				if err := d.Set("group", group); err != nil {
+					return nil, err
+				}
+
+				if err := d.Set("email", email); err != nil {
+					return nil, err
+				}
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+	}
+}
+
+func resourceGroupMembershipCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*backend.Client)
+	membership := &backend.Membership{
+		Group: d.Get("group").(string),
+		Email: d.Get("email").(string),
+	}
+
+	err := client.CreateMembership(membership)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGroupMembershipRead(ctx, d, meta)
+}
+
+func resourceGroupMembershipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*backend.Client)
+
+	group := d.Get("group").(string)
+	email := d.Get("email").(string)
+
+	m, err := client.ReadMembership(group, email)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if m == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.SetId(group + "/" + email)
+
+	return nil
+}
+
+func resourceGroupMembershipDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*backend.Client)
+
+	membership := &backend.Membership{
+		Group: d.Get("group").(string),
+		Email: d.Get("email").(string),
+	}
+
+	err := client.DeleteMembership(membership)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
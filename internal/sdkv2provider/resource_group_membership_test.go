@@ -0,0 +1,51 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testAccResourceGroupMembership(t *testing.T) resource.TestCase {
+	return resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: configResourceGroupMembership,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("corner_group_membership.test", "id", "hitchhikers/ford@prefect.co"),
+				),
+			},
+			{
+				ResourceName:      "corner_group_membership.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	}
+}
+
+const configResourceGroupMembership = `
+provider "corner" {
+  namespace = "corner-group-membership"
+}
+
+resource "corner_user" "test" {
+  email = "ford@prefect.co"
+  name  = "Ford Prefect"
+  age   = 200
+}
+
+resource "corner_group" "test" {
+  name = "hitchhikers"
+}
+
+resource "corner_group_membership" "test" {
+  group = corner_group.test.name
+  email = corner_user.test.email
+}
+`
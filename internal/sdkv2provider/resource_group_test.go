@@ -0,0 +1,42 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testAccResourceGroup(t *testing.T) resource.TestCase {
+	return resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: configResourceGroup,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("corner_group.test", "name", "hitchhikers"),
+					resource.TestCheckResourceAttr("corner_group.test", "description", "Hitchhikers"),
+				),
+			},
+			{
+				ResourceName:      "corner_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	}
+}
+
+const configResourceGroup = `
+provider "corner" {
+  namespace = "corner-group"
+}
+
+resource "corner_group" "test" {
+  name        = "hitchhikers"
+  description = "Hitchhikers"
+}
+`
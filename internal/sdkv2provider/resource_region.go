@@ -0,0 +1,120 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+//nolint:forcetypeassert // Test SDK provider
+package sdkv2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-corner/internal/backend"
+)
+
+func resourceRegion() *schema.Resource {
+	return &schema.Resource{
+		// Prevent any accidental data inconsistencies
+		EnableLegacyTypeSystemPlanErrors:  true,
+		EnableLegacyTypeSystemApplyErrors: true,
+
+		CreateContext: resourceRegionCreate,
+		ReadContext:   resourceRegionRead,
+		UpdateContext: resourceRegionUpdate,
+		DeleteContext: resourceRegionDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				err := d.Set("name", d.Id())
+				if err != nil {
+					return nil, err
+				}
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+	}
+}
+
+func resourceRegionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*backend.Client)
+	region := &backend.Region{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+	}
+
+	err := client.CreateRegion(region)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceRegionRead(ctx, d, meta)
+}
+
+func resourceRegionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*backend.Client)
+
+	name := d.Get("name").(string)
+
+	reg, err := client.ReadRegion(name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if reg == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.SetId(name)
+
+	err = d.Set("description", reg.Description)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceRegionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*backend.Client)
+
+	region := &backend.Region{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+	}
+
+	err := client.UpdateRegion(region)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceRegionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*backend.Client)
+
+	region := &backend.Region{
+		Name: d.Get("name").(string),
+	}
+
+	err := client.DeleteRegion(region)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
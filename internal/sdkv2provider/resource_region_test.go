@@ -0,0 +1,71 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testAccResourceRegion(t *testing.T) resource.TestCase {
+	return resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: configResourceRegion,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("corner_region.test", "name", "Magrathea"),
+					resource.TestCheckResourceAttr("corner_region.test", "description", "Planet factory"),
+					resource.TestCheckResourceAttr("corner_user.test", "region", "Magrathea"),
+				),
+			},
+			{
+				ResourceName:      "corner_region.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// the user is moved out of the region before it is deleted
+				Config: configResourceRegionRemoved,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("corner_user.test", "region", "UK"),
+					resource.TestCheckResourceAttr("corner_user.test", "version", "2"),
+				),
+			},
+		},
+	}
+}
+
+const configResourceRegion = `
+provider "corner" {
+  namespace = "corner-region"
+}
+
+resource "corner_region" "test" {
+  name        = "Magrathea"
+  description = "Planet factory"
+}
+
+resource "corner_user" "test" {
+  email  = "ford@prefect.co"
+  name   = "Ford Prefect"
+  age    = 200
+  region = corner_region.test.name
+}
+`
+
+const configResourceRegionRemoved = `
+provider "corner" {
+  namespace = "corner-region"
+}
+
+resource "corner_user" "test" {
+  email  = "ford@prefect.co"
+  name   = "Ford Prefect"
+  age    = 200
+  region = "UK"
+}
+`
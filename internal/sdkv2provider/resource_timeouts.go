@@ -0,0 +1,132 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+//nolint:forcetypeassert // Test SDK provider
+package sdkv2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-corner/internal/backend"
+)
+
+// resourceTimeouts is for testing timeouts. Create, update and delete each
+// start a backend async operation, and wait for it until the configured
+// timeout elapses.
+func resourceTimeouts() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTimeoutsCreate,
+		ReadContext:   resourceTimeoutsRead,
+		UpdateContext: resourceTimeoutsUpdate,
+		DeleteContext: resourceTimeoutsDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"duration": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "0s",
+				Description: "How long each backend operation runs for, such as 10s.",
+			},
+			"error": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Makes each backend operation fail with the message.",
+			},
+		},
+	}
+}
+
+func resourceTimeoutsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	id, err := resourceTimeoutsRun(ctx, d, meta.(*backend.Client), schema.TimeoutCreate)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id)
+
+	return nil
+}
+
+func resourceTimeoutsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
+
+func resourceTimeoutsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if _, err := resourceTimeoutsRun(ctx, d, meta.(*backend.Client), schema.TimeoutUpdate); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceTimeoutsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if _, err := resourceTimeoutsRun(ctx, d, meta.(*backend.Client), schema.TimeoutDelete); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// resourceTimeoutsRun starts a backend async operation as configured, and
+// waits for it until the timeout for the key elapses. It returns the ID of
+// the operation.
+func resourceTimeoutsRun(ctx context.Context, d *schema.ResourceData, client *backend.Client, timeoutKey string) (string, error) {
+	duration, err := time.ParseDuration(d.Get("duration").(string))
+	if err != nil {
+		return "", fmt.Errorf("invalid duration %q: %w", d.Get("duration").(string), err)
+	}
+
+	id, err := client.StartAsyncOperation(backend.AsyncOperationRequest{
+		RunningDuration: duration,
+		Error:           d.Get("error").(string),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			string(backend.AsyncOperationPending),
+			string(backend.AsyncOperationRunning),
+		},
+		Target: []string{
+			string(backend.AsyncOperationDone),
+		},
+		Refresh: func() (interface{}, string, error) {
+			op, err := client.ReadAsyncOperation(id)
+			if err != nil {
+				return nil, "", err
+			}
+
+			if op == nil {
+				return nil, "", fmt.Errorf("operation %s not found", id)
+			}
+
+			if op.State == backend.AsyncOperationFailed {
+				return op, string(op.State), errors.New(op.Error)
+			}
+
+			return op, string(op.State), nil
+		},
+		Timeout:      d.Timeout(timeoutKey),
+		PollInterval: 100 * time.Millisecond,
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return id, fmt.Errorf("error waiting for operation %s: %w", id, err)
+	}
+
+	return id, nil
+}
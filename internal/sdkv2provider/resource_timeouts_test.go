@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2020, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testAccResourceTimeouts(t *testing.T) resource.TestCase {
+	return resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: configResourceTimeoutsBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(
+						"corner_timeouts.foo", "id", regexp.MustCompile("^op-")),
+				),
+			},
+			{
+				Config: configResourceTimeoutsUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"corner_timeouts.foo", "duration", "200ms"),
+				),
+			},
+		},
+	}
+}
+
+func TestAccResourceTimeouts_createTimeout(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      configResourceTimeoutsCreateTimeout,
+				ExpectError: regexp.MustCompile(`error waiting for operation op-[0-9a-f]+: timeout while waiting for state to become 'done'`),
+			},
+		},
+	})
+}
+
+func TestAccResourceTimeouts_failed(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      configResourceTimeoutsFailed,
+				ExpectError: regexp.MustCompile(`error waiting for operation op-[0-9a-f]+: quota exceeded`),
+			},
+		},
+	})
+}
+
+const configResourceTimeoutsBasic = `
+resource "corner_timeouts" "foo" {
+  duration = "100ms"
+}
+`
+
+const configResourceTimeoutsUpdate = `
+resource "corner_timeouts" "foo" {
+  duration = "200ms"
+
+  timeouts {
+    update = "10s"
+  }
+}
+`
+
+const configResourceTimeoutsCreateTimeout = `
+resource "corner_timeouts" "foo" {
+  duration = "1m"
+
+  timeouts {
+    create = "1s"
+  }
+}
+`
+
+const configResourceTimeoutsFailed = `
+resource "corner_timeouts" "foo" {
+  error = "quota exceeded"
+}
+`
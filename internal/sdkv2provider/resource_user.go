@@ -6,8 +6,11 @@ package sdkv2
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-corner/internal/backend"
 )
@@ -23,6 +26,8 @@ func resourceUser() *schema.Resource {
 		UpdateContext: resourceUserUpdate,
 		DeleteContext: resourceUserDelete,
 
+		CustomizeDiff: resourceUserCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"email": {
 				Type:     schema.TypeString,
@@ -37,19 +42,35 @@ func resourceUser() *schema.Resource {
 				Type:     schema.TypeInt,
 				Required: true,
 			},
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 		},
 	}
 }
 
 func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*backend.Client)
+	client := meta.(*backend.Client).WithContext(ctx)
 	newUser := &backend.User{
-		Email: d.Get("email").(string),
-		Name:  d.Get("name").(string),
-		Age:   d.Get("age").(int),
+		Email:  d.Get("email").(string),
+		Name:   d.Get("name").(string),
+		Age:    d.Get("age").(int),
+		Region: d.Get("region").(string),
+	}
+
+	err := retryThrottled(ctx, d.Timeout(schema.TimeoutCreate), func() error {
+		return client.CreateUser(newUser)
+	})
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	err := client.CreateUser(newUser)
+	err = waitForUser(ctx, client, newUser.Email, newUser.Version, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -58,16 +79,23 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta interf
 }
 
 func resourceUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*backend.Client)
+	client := meta.(*backend.Client).WithContext(ctx)
 
 	email := d.Get("email").(string)
 
-	p, err := client.ReadUser(email)
+	var p *backend.User
+	err := retryThrottled(ctx, d.Timeout(schema.TimeoutRead), func() error {
+		var err error
+		p, err = client.ReadUser(email)
+
+		return err
+	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	if p == nil {
+		d.SetId("")
 		return nil
 	}
 
@@ -81,20 +109,47 @@ func resourceUserRead(ctx context.Context, d *schema.ResourceData, meta interfac
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	err = d.Set("region", p.Region)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("version", p.Version)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	return nil
 }
 
 func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*backend.Client)
+	client := meta.(*backend.Client).WithContext(ctx)
 
 	user := &backend.User{
-		Email: d.Get("email").(string),
-		Name:  d.Get("name").(string),
-		Age:   d.Get("age").(int),
+		Email:  d.Get("email").(string),
+		Name:   d.Get("name").(string),
+		Age:    d.Get("age").(int),
+		Region: d.Get("region").(string),
 	}
 
-	err := client.UpdateUser(user)
+	err := retryThrottled(ctx, d.Timeout(schema.TimeoutUpdate), func() error {
+		if version, _ := d.GetChange("version"); version.(int) != 0 {
+			return client.UpdateUserIfVersion(user, version.(int))
+		}
+
+		return client.UpdateUser(user)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// wait for the update to be visible, or the next read would store the
+	// previous version and the update after it would conflict
+	err = waitForUser(ctx, client, user.Email, user.Version, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = d.Set("version", user.Version)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -103,7 +158,7 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 }
 
 func resourceUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*backend.Client)
+	client := meta.(*backend.Client).WithContext(ctx)
 
 	user := &backend.User{
 		Email: d.Get("email").(string),
@@ -111,10 +166,74 @@ func resourceUserDelete(ctx context.Context, d *schema.ResourceData, meta interf
 		Age:   d.Get("age").(int),
 	}
 
-	err := client.DeleteUser(user)
+	err := retryThrottled(ctx, d.Timeout(schema.TimeoutDelete), func() error {
+		if version := d.Get("version").(int); version != 0 {
+			return client.DeleteUserIfVersion(user, version)
+		}
+
+		return client.DeleteUser(user)
+	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	return nil
 }
+
+// resourceUserCustomizeDiff marks version as unknown for updates, as every
+// update increments it in the backend.
+func resourceUserCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChanges("name", "age", "region") {
+		return nil
+	}
+
+	return d.SetNewComputed("version")
+}
+
+// waitForUser reads the user until it is found at the given version or later,
+// as an eventually consistent backend may return the previous version of the
+// user, or none, right after a write.
+func waitForUser(ctx context.Context, client *backend.Client, email string, version int, timeout time.Duration) error {
+	return retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		p, err := client.ReadUser(email)
+		if _, ok := backend.RetryAfter(err); ok {
+			return retry.RetryableError(err)
+		}
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		if p == nil || p.Version < version {
+			return retry.RetryableError(fmt.Errorf("could not find version %d of user with email %s after it was written", version, email))
+		}
+
+		return nil
+	})
+}
+
+// retryThrottled calls fn until it does not fail with a throttling error,
+// waiting for the retry-after hint of the error between calls. It gives up
+// after timeout, or once ctx is done, or would be before the hint elapses.
+func retryThrottled(ctx context.Context, timeout time.Duration, fn func() error) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for {
+		err := fn()
+
+		after, ok := backend.RetryAfter(err)
+		if !ok {
+			return err
+		}
+
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < after {
+			return fmt.Errorf("giving up retrying, as the deadline is before the retry-after of %s: %w", after, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("giving up retrying: %w", err)
+		case <-time.After(after):
+		}
+	}
+}
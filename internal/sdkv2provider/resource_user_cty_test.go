@@ -4,9 +4,14 @@
 package sdkv2
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+
+	"github.com/hashicorp/terraform-provider-corner/internal/backend"
+	"github.com/hashicorp/terraform-provider-corner/internal/cornertesting"
 )
 
 func testAccResourceUserCty(t *testing.T) resource.TestCase {
@@ -40,6 +45,52 @@ func testAccResourceUserCty(t *testing.T) resource.TestCase {
 	}
 }
 
+func TestAccResourceUserCty_drift(t *testing.T) {
+	namespace := "corner-user-cty-drift"
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: configResourceUserCtyDrift(namespace),
+				Check: resource.TestCheckResourceAttr(
+					"corner_user_cty.foo", "name", "Ford Prefect"),
+			},
+			{
+				PreConfig: cornertesting.PreConfigDrift(t, namespace,
+					cornertesting.MutateUser("ford@prefect.co", func(u *backend.User) {
+						u.Name = "Zaphod Beeblebrox"
+						u.Age = 42
+					}),
+				),
+				Config: configResourceUserCtyDrift(namespace),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("corner_user_cty.foo", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"corner_user_cty.foo", "name", "Ford Prefect"),
+					resource.TestCheckResourceAttr(
+						"corner_user_cty.foo", "age", "200"),
+				),
+			},
+			{
+				PreConfig: cornertesting.PreConfigDrift(t, namespace,
+					cornertesting.DeleteUserOutOfBand("ford@prefect.co"),
+				),
+				Config: configResourceUserCtyDrift(namespace),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("corner_user_cty.foo", plancheck.ResourceActionCreate),
+					},
+				},
+			},
+		},
+	})
+}
+
 const configResourceUserCtyBasic = `
 resource "corner_user_cty" "foo" {
   email = "ford@prefect.co"
@@ -55,3 +106,17 @@ resource "corner_user_cty" "foo" {
   age = 300
 }
 `
+
+func configResourceUserCtyDrift(namespace string) string {
+	return fmt.Sprintf(`
+provider "corner" {
+  namespace = %q
+}
+
+resource "corner_user_cty" "foo" {
+  email = "ford@prefect.co"
+  name  = "Ford Prefect"
+  age   = 200
+}
+`, namespace)
+}
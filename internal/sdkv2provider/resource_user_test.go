@@ -4,10 +4,17 @@
 package sdkv2
 
 import (
+	"fmt"
+	"net/http/httptest"
 	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/hashicorp/terraform-provider-corner/internal/backend"
+	"github.com/hashicorp/terraform-provider-corner/internal/cornertesting"
 )
 
 func testAccResourceUser(t *testing.T) resource.TestCase {
@@ -20,6 +27,8 @@ func testAccResourceUser(t *testing.T) resource.TestCase {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestMatchResourceAttr(
 						"corner_user.foo", "name", regexp.MustCompile("^For")),
+					resource.TestCheckResourceAttr(
+						"corner_user.foo", "version", "1"),
 				),
 			},
 		},
@@ -33,3 +42,366 @@ resource "corner_user" "foo" {
   age = 200
 }
 `
+
+func TestAccResourceUser_version(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: configResourceUserVersion("Ford Prefect"),
+				Check: resource.TestCheckResourceAttr(
+					"corner_user.foo", "version", "1"),
+			},
+			{
+				Config: configResourceUserVersion("Arthur Dent"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"corner_user.foo", "name", "Arthur Dent"),
+					resource.TestCheckResourceAttr(
+						"corner_user.foo", "version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceUser_eventualConsistency(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: configResourceUserEventualConsistency,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"corner_user.foo", "name", "Ford Prefect"),
+					resource.TestCheckResourceAttr(
+						"corner_user.foo", "id", "ford@prefect.co"),
+				),
+			},
+		},
+	})
+}
+
+// Every write waits until it is visible, so an update is not made against a
+// stale read of the version before the previous update, which would conflict.
+func TestAccResourceUser_eventualConsistencyUpdate(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: configResourceUserEventualConsistencyUpdate("Ford Prefect"),
+				Check: resource.TestCheckResourceAttr(
+					"corner_user.foo", "version", "1"),
+			},
+			{
+				Config: configResourceUserEventualConsistencyUpdate("Arthur Dent"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"corner_user.foo", "name", "Arthur Dent"),
+					resource.TestCheckResourceAttr(
+						"corner_user.foo", "version", "2"),
+				),
+			},
+			{
+				Config: configResourceUserEventualConsistencyUpdate("Zaphod Beeblebrox"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"corner_user.foo", "name", "Zaphod Beeblebrox"),
+					resource.TestCheckResourceAttr(
+						"corner_user.foo", "version", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceUser_endpoint(t *testing.T) {
+	server, err := backend.NewClient(backend.WithFreshDatabase())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ts := httptest.NewServer(backend.NewHandler(server))
+	t.Cleanup(ts.Close)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: configResourceUserEndpoint(ts.URL, "Ford Prefect"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"corner_user.foo", "version", "1"),
+					func(_ *terraform.State) error {
+						u, err := server.ReadUser("ford@prefect.co")
+						if err != nil {
+							return err
+						}
+
+						if u == nil {
+							return fmt.Errorf("expected user to be created on %s", ts.URL)
+						}
+
+						return nil
+					},
+				),
+			},
+			{
+				Config: configResourceUserEndpoint(ts.URL, "Arthur Dent"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"corner_user.foo", "name", "Arthur Dent"),
+					resource.TestCheckResourceAttr(
+						"corner_user.foo", "version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceUser_drift(t *testing.T) {
+	namespace := "corner-user-drift"
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: configResourceUserDrift(namespace),
+				Check: resource.TestCheckResourceAttr(
+					"corner_user.foo", "version", "1"),
+			},
+			{
+				PreConfig: cornertesting.PreConfigDrift(t, namespace,
+					cornertesting.MutateUser("ford@prefect.co", func(u *backend.User) {
+						u.Name = "Zaphod Beeblebrox"
+					}),
+				),
+				Config: configResourceUserDrift(namespace),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("corner_user.foo", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"corner_user.foo", "name", "Ford Prefect"),
+					resource.TestCheckResourceAttr(
+						"corner_user.foo", "version", "3"),
+				),
+			},
+			{
+				PreConfig: cornertesting.PreConfigDrift(t, namespace,
+					cornertesting.DeleteUserOutOfBand("ford@prefect.co"),
+				),
+				Config: configResourceUserDrift(namespace),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("corner_user.foo", plancheck.ResourceActionCreate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr(
+					"corner_user.foo", "version", "1"),
+			},
+			{
+				// only the computed version drifts, which does not need an update
+				PreConfig: cornertesting.PreConfigDrift(t, namespace,
+					cornertesting.InjectUser(backend.User{
+						Email: "ford@prefect.co",
+						Name:  "Ford Prefect",
+						Age:   200,
+					}),
+				),
+				Config: configResourceUserDrift(namespace),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("corner_user.foo", plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.TestCheckResourceAttr(
+					"corner_user.foo", "version", "2"),
+			},
+		},
+	})
+}
+
+func TestAccResourceUser_fault(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      configResourceUserFault,
+				ExpectError: regexp.MustCompile(`injected fault in CreateUser \(call 1\): service unavailable`),
+			},
+		},
+	})
+}
+
+const configResourceUserFault = `
+provider "corner" {
+  namespace = "corner-user-fault"
+
+  fault {
+    operation = "CreateUser"
+    error     = "service unavailable"
+  }
+}
+
+resource "corner_user" "foo" {
+  email = "ford@prefect.co"
+  name  = "Ford Prefect"
+  age   = 200
+}
+`
+
+func TestAccResourceUser_rateLimit(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				// most operations are throttled, and retried once allowed
+				Config: configResourceUserRateLimit,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"corner_user.foo.0", "version", "1"),
+					resource.TestCheckResourceAttr(
+						"corner_user.foo.1", "version", "1"),
+					resource.TestCheckResourceAttr(
+						"corner_user.foo.2", "version", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceUser_quota(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: configResourceUserQuota("ford@prefect.co", "Ford Prefect"),
+			},
+			{
+				// the replacement deletes the user before creating the new
+				// one, which frees the quota
+				Config: configResourceUserQuota("arthur@dent.co", "Arthur Dent"),
+				Check: resource.TestCheckResourceAttr(
+					"corner_user.foo", "email", "arthur@dent.co"),
+			},
+			{
+				// a quota is not a rate limit, so the create fails without
+				// being retried
+				Config: configResourceUserQuota("arthur@dent.co", "Arthur Dent") + `
+resource "corner_user" "bar" {
+  email = "zaphod@beeblebrox.co"
+  name  = "Zaphod Beeblebrox"
+  age   = 200
+}
+`,
+				ExpectError: regexp.MustCompile(`Quota exceeded for users`),
+			},
+		},
+	})
+}
+
+func configResourceUserVersion(name string) string {
+	return fmt.Sprintf(`
+provider "corner" {
+  namespace = "corner-user-version"
+}
+
+resource "corner_user" "foo" {
+  email = "ford@prefect.co"
+  name  = %q
+  age   = 200
+}
+`, name)
+}
+
+const configResourceUserEventualConsistency = `
+provider "corner" {
+  namespace              = "corner-user-eventual-consistency"
+  read_after_write_delay = "2s"
+}
+
+resource "corner_user" "foo" {
+  email = "ford@prefect.co"
+  name  = "Ford Prefect"
+  age   = 200
+}
+`
+
+func configResourceUserEventualConsistencyUpdate(name string) string {
+	return fmt.Sprintf(`
+provider "corner" {
+  namespace              = "corner-user-eventual-consistency-update"
+  read_after_write_delay = "2s"
+}
+
+resource "corner_user" "foo" {
+  email = "ford@prefect.co"
+  name  = %q
+  age   = 200
+}
+`, name)
+}
+
+func configResourceUserEndpoint(endpoint, name string) string {
+	return fmt.Sprintf(`
+provider "corner" {
+  endpoint = %q
+}
+
+resource "corner_user" "foo" {
+  email = "ford@prefect.co"
+  name  = %q
+  age   = 200
+}
+`, endpoint, name)
+}
+
+func configResourceUserDrift(namespace string) string {
+	return fmt.Sprintf(`
+provider "corner" {
+  namespace = %q
+}
+
+resource "corner_user" "foo" {
+  email = "ford@prefect.co"
+  name  = "Ford Prefect"
+  age   = 200
+}
+`, namespace)
+}
+
+const configResourceUserRateLimit = `
+provider "corner" {
+  namespace        = "corner-user-rate-limit"
+  rate_limit       = 5
+  rate_limit_burst = 1
+}
+
+resource "corner_user" "foo" {
+  count = 3
+
+  email = "user${count.index}@prefect.co"
+  name  = "Ford Prefect"
+  age   = 200
+}
+`
+
+func configResourceUserQuota(email, name string) string {
+	return fmt.Sprintf(`
+provider "corner" {
+  namespace = "corner-user-quota"
+  quotas = {
+    users = 1
+  }
+}
+
+resource "corner_user" "foo" {
+  email = %q
+  name  = %q
+  age   = 200
+}
+`, email, name)
+}
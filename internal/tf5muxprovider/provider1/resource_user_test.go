@@ -25,6 +25,10 @@ func TestAccResourceUser1(t *testing.T) {
 }
 
 const configResourceBasic = `
+provider "tf5muxprovider" {
+  namespace = "tf5muxprovider-provider1"
+}
+
 resource "tf5muxprovider_user1" "example" {
   age   = 200
   email = "example@example.com"
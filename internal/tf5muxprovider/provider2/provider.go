@@ -13,6 +13,12 @@ import (
 
 func New() *schema.Provider {
 	p := &schema.Provider{
+		Schema: map[string]*schema.Schema{
+			"namespace": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
 		DataSourcesMap: map[string]*schema.Resource{},
 		ResourcesMap: map[string]*schema.Resource{
 			"tf5muxprovider_user2": resourceUser(),
@@ -25,8 +31,13 @@ func New() *schema.Provider {
 }
 
 func configure(p *schema.Provider) func(context.Context, *schema.ResourceData) (interface{}, diag.Diagnostics) {
-	return func(context.Context, *schema.ResourceData) (interface{}, diag.Diagnostics) {
-		client, err := backend.NewClient()
+	return func(_ context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		var opts []backend.Option
+		if namespace, ok := d.Get("namespace").(string); ok && namespace != "" {
+			opts = append(opts, backend.WithNamespace(namespace))
+		}
+
+		client, err := backend.NewClient(opts...)
 		if err != nil {
 			return nil, diag.FromErr(err)
 		}
@@ -19,6 +19,8 @@ func resourceUser() *schema.Resource {
 		UpdateContext: resourceUserUpdate,
 		DeleteContext: resourceUserDelete,
 
+		CustomizeDiff: resourceUserCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"email": {
 				Type:     schema.TypeString,
@@ -33,6 +35,10 @@ func resourceUser() *schema.Resource {
 				Type:     schema.TypeInt,
 				Required: true,
 			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -77,6 +83,10 @@ func resourceUserRead(ctx context.Context, d *schema.ResourceData, meta interfac
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	err = d.Set("version", p.Version)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	return nil
 }
@@ -90,7 +100,17 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 		Age:   d.Get("age").(int),
 	}
 
-	err := client.UpdateUser(user)
+	var err error
+	if version, _ := d.GetChange("version"); version.(int) == 0 {
+		err = client.UpdateUser(user)
+	} else {
+		err = client.UpdateUserIfVersion(user, version.(int))
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = d.Set("version", user.Version)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -107,10 +127,25 @@ func resourceUserDelete(ctx context.Context, d *schema.ResourceData, meta interf
 		Age:   d.Get("age").(int),
 	}
 
-	err := client.DeleteUser(user)
+	var err error
+	if version := d.Get("version").(int); version == 0 {
+		err = client.DeleteUser(user)
+	} else {
+		err = client.DeleteUserIfVersion(user, version)
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	return nil
 }
+
+// resourceUserCustomizeDiff marks version as unknown for updates, as every
+// update increments it in the backend.
+func resourceUserCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChanges("name", "age") {
+		return nil
+	}
+
+	return d.SetNewComputed("version")
+}
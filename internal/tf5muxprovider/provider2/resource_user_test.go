@@ -25,6 +25,10 @@ func TestAccResourceUser2(t *testing.T) {
 }
 
 const configResourceBasic = `
+provider "tf5muxprovider" {
+  namespace = "tf5muxprovider-provider2"
+}
+
 resource "tf5muxprovider_user2" "example" {
   age   = 200
   email = "example@example.com"
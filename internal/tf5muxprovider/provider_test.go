@@ -26,9 +26,11 @@ func TestAccResourceUser(t *testing.T) {
 					resource.TestCheckResourceAttr("tf5muxprovider_user1.example", "age", "123"),
 					resource.TestCheckResourceAttr("tf5muxprovider_user1.example", "email", "example1@example.com"),
 					resource.TestCheckResourceAttr("tf5muxprovider_user1.example", "name", "Example Name 1"),
+					resource.TestCheckResourceAttr("tf5muxprovider_user1.example", "version", "1"),
 					resource.TestCheckResourceAttr("tf5muxprovider_user2.example", "age", "234"),
 					resource.TestCheckResourceAttr("tf5muxprovider_user2.example", "email", "example2@example.com"),
 					resource.TestCheckResourceAttr("tf5muxprovider_user2.example", "name", "Example Name 2"),
+					resource.TestCheckResourceAttr("tf5muxprovider_user2.example", "version", "1"),
 				),
 			},
 		},
@@ -36,6 +38,10 @@ func TestAccResourceUser(t *testing.T) {
 }
 
 const configResourceUserBasic = `
+provider "tf5muxprovider" {
+  namespace = "tf5muxprovider"
+}
+
 resource "tf5muxprovider_user1" "example" {
   age   = 123
   email = "example1@example.com"
@@ -25,6 +25,10 @@ func TestAccResourceUser(t *testing.T) {
 }
 
 const configResourceBasic = `
+provider "tf5to6provider" {
+  namespace = "tf5to6provider-provider"
+}
+
 resource "tf5to6provider_user" "example" {
   age   = 200
   email = "example@example.com"
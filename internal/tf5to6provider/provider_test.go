@@ -26,6 +26,7 @@ func TestAccResourceUser(t *testing.T) {
 					resource.TestCheckResourceAttr("tf5to6provider_user.example", "age", "123"),
 					resource.TestCheckResourceAttr("tf5to6provider_user.example", "email", "example@example.com"),
 					resource.TestCheckResourceAttr("tf5to6provider_user.example", "name", "Example Name"),
+					resource.TestCheckResourceAttr("tf5to6provider_user.example", "version", "1"),
 				),
 			},
 		},
@@ -33,6 +34,10 @@ func TestAccResourceUser(t *testing.T) {
 }
 
 const configResourceUserBasic = `
+provider "tf5to6provider" {
+  namespace = "tf5to6provider"
+}
+
 resource "tf5to6provider_user" "example" {
   age   = 123
   email = "example@example.com"
@@ -5,6 +5,7 @@ package provider1
 
 import (
 	"context"
+	"errors"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -61,6 +62,9 @@ func (r *resourceUser) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"version": schema.Int64Attribute{
+				Computed: true,
+			},
 		},
 	}
 }
@@ -84,6 +88,7 @@ type user struct {
 	Age        int          `tfsdk:"age"`
 	DateJoined types.String `tfsdk:"date_joined"`
 	Language   types.String `tfsdk:"language"`
+	Version    types.Int64  `tfsdk:"version"`
 }
 
 func (r resourceUser) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -121,6 +126,7 @@ func (r resourceUser) Create(ctx context.Context, req resource.CreateRequest, re
 	}
 	plan.DateJoined = types.StringValue(p.DateJoined)
 	plan.Language = types.StringValue(p.Language)
+	plan.Version = types.Int64Value(int64(p.Version))
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -151,6 +157,7 @@ func (r resourceUser) Read(ctx context.Context, req resource.ReadRequest, resp *
 	state.Age = p.Age
 	state.DateJoined = types.StringValue(p.DateJoined)
 	state.Language = types.StringValue(p.Language)
+	state.Version = types.Int64Value(int64(p.Version))
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -160,9 +167,11 @@ func (r resourceUser) Read(ctx context.Context, req resource.ReadRequest, resp *
 }
 
 func (r resourceUser) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan user
+	var plan, state user
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -176,12 +185,26 @@ func (r resourceUser) Update(ctx context.Context, req resource.UpdateRequest, re
 		newUser.Language = plan.Language.ValueString()
 	}
 
-	err := r.client.UpdateUser(newUser)
+	var err error
+	if state.Version.IsNull() {
+		err = r.client.UpdateUser(newUser)
+	} else {
+		err = r.client.UpdateUserIfVersion(newUser, int(state.Version.ValueInt64()))
+	}
+
+	var conflictErr *backend.ConflictError
+	if errors.As(err, &conflictErr) {
+		resp.Diagnostics.AddError("Error updating user", "The user was modified outside of Terraform since it was last read, refresh and try again: "+err.Error())
+		return
+	}
+
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating user", err.Error())
 		return
 	}
 
+	plan.Version = types.Int64Value(int64(newUser.Version))
+
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -203,7 +226,13 @@ func (r resourceUser) Delete(ctx context.Context, req resource.DeleteRequest, re
 		Age:   state.Age,
 	}
 
-	err := r.client.DeleteUser(userToDelete)
+	var err error
+	if state.Version.IsNull() {
+		err = r.client.DeleteUser(userToDelete)
+	} else {
+		err = r.client.DeleteUserIfVersion(userToDelete, int(state.Version.ValueInt64()))
+	}
+
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting user", err.Error())
 		return
@@ -31,6 +31,10 @@ func TestAccResourceUser1(t *testing.T) {
 }
 
 const configResourceUserBasic = `
+provider "tf6muxprovider" {
+  namespace = "tf6muxprovider-provider1"
+}
+
 resource "tf6muxprovider_user1" "example" {
   age   = 123
   email = "example@example.com"
@@ -31,6 +31,10 @@ func TestAccResourceUser2(t *testing.T) {
 }
 
 const configResourceUserBasic = `
+provider "tf6muxprovider" {
+  namespace = "tf6muxprovider-provider2"
+}
+
 resource "tf6muxprovider_user2" "example" {
   age   = 123
   email = "example@example.com"
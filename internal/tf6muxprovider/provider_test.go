@@ -30,10 +30,12 @@ func TestAccResourceUser(t *testing.T) {
 					resource.TestCheckResourceAttr("tf6muxprovider_user1.example", "email", "example1@example.com"),
 					resource.TestCheckResourceAttr("tf6muxprovider_user1.example", "language", "en"),
 					resource.TestCheckResourceAttr("tf6muxprovider_user1.example", "name", "Example Name 1"),
+					resource.TestCheckResourceAttr("tf6muxprovider_user1.example", "version", "1"),
 					resource.TestCheckResourceAttr("tf6muxprovider_user2.example", "age", "234"),
 					resource.TestCheckResourceAttr("tf6muxprovider_user2.example", "email", "example2@example.com"),
 					resource.TestCheckResourceAttr("tf6muxprovider_user2.example", "language", "en"),
 					resource.TestCheckResourceAttr("tf6muxprovider_user2.example", "name", "Example Name 2"),
+					resource.TestCheckResourceAttr("tf6muxprovider_user2.example", "version", "1"),
 				),
 			},
 		},
@@ -41,6 +43,10 @@ func TestAccResourceUser(t *testing.T) {
 }
 
 const configResourceUserBasic = `
+provider "tf6muxprovider" {
+  namespace = "tf6muxprovider"
+}
+
 resource "tf6muxprovider_user1" "example" {
   age   = 123
   email = "example1@example.com"
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/hashicorp/terraform-provider-corner/internal/backend"
 )
@@ -31,12 +32,31 @@ func (p *testProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp
 			"example": schema.StringAttribute{
 				Optional: true,
 			},
+			"namespace": schema.StringAttribute{
+				Optional: true,
+			},
 		},
 	}
 }
 
+type providerConfig struct {
+	Example   types.String `tfsdk:"example"`
+	Namespace types.String `tfsdk:"namespace"`
+}
+
 func (p *testProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
-	client, err := backend.NewClient()
+	var config providerConfig
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var opts []backend.Option
+	if !config.Namespace.IsNull() {
+		opts = append(opts, backend.WithNamespace(config.Namespace.ValueString()))
+	}
+
+	client, err := backend.NewClient(opts...)
 	if err != nil {
 		resp.Diagnostics.AddError("Error initialising client", err.Error())
 	}
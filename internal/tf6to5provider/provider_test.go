@@ -30,6 +30,7 @@ func TestAccResourceUser(t *testing.T) {
 					resource.TestCheckResourceAttr("tf6to5provider_user.example", "email", "example@example.com"),
 					resource.TestCheckResourceAttr("tf6to5provider_user.example", "language", "en"),
 					resource.TestCheckResourceAttr("tf6to5provider_user.example", "name", "Example Name"),
+					resource.TestCheckResourceAttr("tf6to5provider_user.example", "version", "1"),
 				),
 			},
 		},
@@ -37,6 +38,10 @@ func TestAccResourceUser(t *testing.T) {
 }
 
 const configResourceUserBasic = `
+provider "tf6to5provider" {
+  namespace = "tf6to5provider"
+}
+
 resource "tf6to5provider_user" "example" {
   age   = 123
   email = "example@example.com"